@@ -0,0 +1,194 @@
+package str
+
+import "unicode/utf8"
+
+// acMatcher is an Aho-Corasick automaton over the runes of a set of
+// patterns. It is immutable once built and safe for concurrent use.
+type acMatcher struct {
+	fold   bool
+	edges  map[acEdge]int32
+	nodes  []acNode
+	lens   []int   // pattern lengths in runes
+	ids    []int32 // pattern index each duplicate resolves to, -1 if empty
+	maxLen int
+}
+
+type acEdge struct {
+	node int32
+	r    rune
+}
+
+type acNode struct {
+	fail  int32
+	depth int32
+	// pattern is the index of the pattern spelled by the path to this node,
+	// longest the index of the longest pattern that is a suffix of it. Both
+	// are -1 when there is none. dict is the nearest node on the failure
+	// chain that spells a pattern, or 0.
+	pattern int32
+	longest int32
+	dict    int32
+}
+
+// newACMatcher builds a matcher for patterns. Empty patterns never match and
+// duplicates resolve to the first occurrence. With fold set, runes are
+// compared under Unicode simple case folding.
+func newACMatcher(patterns []string, fold bool) *acMatcher {
+	m := &acMatcher{
+		fold:  fold,
+		edges: make(map[acEdge]int32),
+		nodes: []acNode{{pattern: -1, longest: -1}},
+		lens:  make([]int, len(patterns)),
+		ids:   make([]int32, len(patterns)),
+	}
+
+	children := [][]rune{nil}
+	for i, p := range patterns {
+		node := int32(0)
+		for _, r := range p {
+			if fold {
+				r = foldRune(r)
+			}
+			next, ok := m.edges[acEdge{node, r}]
+			if !ok {
+				next = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{depth: m.nodes[node].depth + 1, pattern: -1, longest: -1})
+				children = append(children, nil)
+				children[node] = append(children[node], r)
+				m.edges[acEdge{node, r}] = next
+			}
+			node = next
+			m.lens[i]++
+		}
+		if node != 0 && m.nodes[node].pattern < 0 {
+			m.nodes[node].pattern = int32(i)
+		}
+		m.ids[i] = m.nodes[node].pattern
+		m.maxLen = max(m.maxLen, m.lens[i])
+	}
+
+	queue := []int32{0}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		n := &m.nodes[node]
+		if node != 0 {
+			f := m.nodes[n.fail]
+			n.longest, n.dict = f.longest, f.dict
+			if f.pattern >= 0 {
+				n.dict = n.fail
+			}
+			if n.pattern >= 0 {
+				n.longest = n.pattern
+			}
+		}
+
+		for _, r := range children[node] {
+			child := m.edges[acEdge{node, r}]
+			if node != 0 {
+				m.nodes[child].fail = m.step(n.fail, r)
+			}
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// step returns the state reached from node on r, following failure links.
+func (m *acMatcher) step(node int32, r rune) int32 {
+	for {
+		if next, ok := m.edges[acEdge{node, r}]; ok {
+			return next
+		}
+		if node == 0 {
+			return 0
+		}
+		node = m.nodes[node].fail
+	}
+}
+
+// next returns the byte offsets and pattern index of the leftmost-longest
+// match in s starting at or after from, or a pattern index of -1.
+func (m *acMatcher) next(s string, from int) (start, end, pattern int) {
+	// starts holds the offsets of the last maxLen+1 runes read, enough to
+	// locate the start of any match or partial match.
+	starts := make([]int, m.maxLen+1)
+	pattern = -1
+	state := int32(0)
+	for i, pos := 0, from; pos < len(s); i++ {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		if m.fold {
+			r = foldRune(r)
+		}
+		starts[i%len(starts)] = pos
+		state = m.step(state, r)
+		pos += size
+
+		if p := int(m.nodes[state].longest); p >= 0 {
+			at := starts[(i+1-m.lens[p])%len(starts)]
+			if pattern < 0 || at < start || (at == start && pos > end) {
+				start, end, pattern = at, pos, p
+			}
+		}
+		if pattern >= 0 {
+			live := pos
+			if d := int(m.nodes[state].depth); d > 0 {
+				live = starts[(i+1-d)%len(starts)]
+			}
+			if live > start {
+				return start, end, pattern
+			}
+		}
+	}
+	return start, end, pattern
+}
+
+// each calls fn with the byte offsets and pattern index of every match in s,
+// including overlapping ones, in order of their end. It stops early when fn
+// returns false.
+func (m *acMatcher) each(s string, fn func(start, end, pattern int) bool) {
+	starts := make([]int, m.maxLen+1)
+	state := int32(0)
+	for i, pos := 0, 0; pos < len(s); i++ {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		if m.fold {
+			r = foldRune(r)
+		}
+		starts[i%len(starts)] = pos
+		state = m.step(state, r)
+		pos += size
+
+		node := state
+		if m.nodes[node].pattern < 0 {
+			node = m.nodes[node].dict
+		}
+		for ; node != 0; node = m.nodes[node].dict {
+			p := int(m.nodes[node].pattern)
+			if !fn(starts[(i+1-m.lens[p])%len(starts)], pos, p) {
+				return
+			}
+		}
+	}
+}
+
+// tail returns the offset of the longest suffix of s that is a proper start of
+// some pattern, or len(s) if there is none. Text before it cannot be part of a
+// match that continues past the end of s.
+func (m *acMatcher) tail(s string) int {
+	starts := make([]int, m.maxLen+1)
+	state := int32(0)
+	i := 0
+	for pos := 0; pos < len(s); i++ {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		if m.fold {
+			r = foldRune(r)
+		}
+		starts[i%len(starts)] = pos
+		state = m.step(state, r)
+		pos += size
+	}
+	if d := int(m.nodes[state].depth); d > 0 {
+		return starts[(i-d)%len(starts)]
+	}
+	return len(s)
+}
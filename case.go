@@ -2,6 +2,7 @@ package str
 
 import (
 	"strings"
+	"sync"
 	"unicode"
 
 	"github.com/go-openapi/inflect"
@@ -9,68 +10,212 @@ import (
 	"golang.org/x/text/language"
 )
 
-var (
-	casingRules = setCasingRules()
-	acronyms    = make(map[string]struct{})
-)
+var defaultAcronyms = []string{"ACL", "API", "ASCII", "AWS", "CPU", "CSS", "DNS", "EOF", "GB", "GUID", "HCL", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON", "KB", "LHS", "MAC", "MB", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "SSO", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "URI", "URL", "UTF8", "UUID", "VM", "XML", "XMPP", "XSRF", "XSS"}
+
+var defaultCaser = NewCaser()
+
+// Caser converts strings between naming conventions. It owns its acronym set,
+// inflection ruleset, word separator and locale, and is safe for concurrent use.
+type Caser struct {
+	mu          sync.RWMutex
+	acronyms    map[string]struct{}
+	rules       *inflect.Ruleset
+	separator   func(rune) bool
+	locale      language.Tag
+	letterDigit bool
+	digitLetter bool
+}
+
+type CaserOption func(*Caser)
 
-func setCasingRules() *inflect.Ruleset {
-	rules := inflect.NewDefaultRuleset()
-	for _, w := range []string{"ACL", "API", "ASCII", "AWS", "CPU", "CSS", "DNS", "EOF", "GB", "GUID", "HCL", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON", "KB", "LHS", "MAC", "MB", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "SSO", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "URI", "URL", "UTF8", "UUID", "VM", "XML", "XMPP", "XSRF", "XSS"} {
-		acronyms[w] = struct{}{}
-		rules.AddAcronym(w)
+// WithAcronyms adds words to the acronym set used by GoPascal and GoCamel.
+func WithAcronyms(words ...string) CaserOption {
+	return func(c *Caser) {
+		for _, w := range words {
+			c.acronyms[strings.ToUpper(w)] = struct{}{}
+		}
+	}
+}
+
+// WithoutAcronyms removes words from the acronym set, or clears it when no
+// words are given.
+func WithoutAcronyms(words ...string) CaserOption {
+	return func(c *Caser) {
+		if len(words) == 0 {
+			clear(c.acronyms)
+		}
+		for _, w := range words {
+			delete(c.acronyms, strings.ToUpper(w))
+		}
+	}
+}
+
+// WithRuleset replaces the inflection ruleset. AddIrregular, AddPlural,
+// AddSingular and AddUncountable modify it, so a ruleset given to one caser
+// should not be shared with others.
+func WithRuleset(rules *inflect.Ruleset) CaserOption {
+	return func(c *Caser) {
+		if rules != nil {
+			c.rules = rules
+		}
+	}
+}
+
+// WithSeparator sets the predicate reporting runes that separate words.
+func WithSeparator(fn func(rune) bool) CaserOption {
+	return func(c *Caser) {
+		if fn != nil {
+			c.separator = fn
+		}
 	}
-	return rules
 }
 
-func goPascalWords(words []string) string {
+// WithLetterDigitSplit controls whether a digit following a letter starts a
+// new word, as in "utf8" becoming "utf", "8".
+func WithLetterDigitSplit(split bool) CaserOption {
+	return func(c *Caser) {
+		c.letterDigit = split
+	}
+}
+
+// WithDigitLetterSplit controls whether a letter following a digit starts a
+// new word, as in "2fa" becoming "2", "fa".
+func WithDigitLetterSplit(split bool) CaserOption {
+	return func(c *Caser) {
+		c.digitLetter = split
+	}
+}
+
+func WithLocale(tag language.Tag) CaserOption {
+	return func(c *Caser) {
+		c.locale = tag
+	}
+}
+
+func NewCaser(opts ...CaserOption) *Caser {
+	c := &Caser{
+		acronyms:  make(map[string]struct{}, len(defaultAcronyms)),
+		separator: isWordSeparator,
+		locale:    language.English,
+	}
+	WithAcronyms(defaultAcronyms...)(c)
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.rules == nil {
+		c.rules = inflect.NewDefaultRuleset()
+	}
+	return c
+}
+
+func (c *Caser) AddAcronyms(words ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, w := range words {
+		c.acronyms[strings.ToUpper(w)] = struct{}{}
+	}
+}
+
+func (c *Caser) IsAcronym(word string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.acronyms[strings.ToUpper(word)]
+	return ok
+}
+
+func (c *Caser) Locale() language.Tag {
+	return c.locale
+}
+
+func (c *Caser) Camel(s Str) Str {
+	return c.lowerFirst(c.pascal(s, c.locale), c.locale)
+}
+
+func (c *Caser) GoCamel(s Str) Str {
+	return c.lowerFirst(c.GoPascal(s), c.locale)
+}
+
+func (c *Caser) GoPascal(s Str) Str {
+	words := c.words(string(s))
+	lower := cases.Lower(c.locale)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	for i, w := range words {
+		w = lower.String(w)
 		upper := strings.ToUpper(w)
-		if _, ok := acronyms[upper]; ok {
+		if _, ok := c.acronyms[upper]; ok {
 			words[i] = upper
+		} else if _, ok := c.acronyms[strings.TrimSuffix(upper, "S")]; ok && strings.HasSuffix(w, "s") {
+			words[i] = upper[:len(upper)-1] + "s"
 		} else {
-			words[i] = casingRules.Capitalize(w)
+			words[i] = string(c.upperFirst(Str(w), c.locale))
 		}
 	}
-	return strings.Join(words, "")
+	return Str(strings.Join(words, ""))
 }
 
-func pascalWords(words []string) string {
-	caser := cases.Title(language.English)
-	for i, w := range words {
-		words[i] = caser.String(strings.ToLower(w))
-	}
-	return strings.Join(words, "")
+// Words splits s into the words the caser converts between conventions.
+func (c *Caser) Words(s Str) Array {
+	return NewArray(c.words(string(s)))
 }
 
-func splitWords(s string) []string {
-	var words []string
-	var lastIndex, lastUpper int
-	for i, r := range s {
-		if unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r) {
-			if w := s[lastIndex:i]; w != "" {
-				words = append(words, w)
-			}
-			lastIndex = i + 1
-			continue
-		}
+func (c *Caser) Kebab(s Str) Str {
+	return c.Lower(Str("-").Join(c.words(string(s))))
+}
 
-		if unicode.IsUpper(r) {
-			seq := i-1 == lastUpper
-			lastUpper = i
-			if seq {
-				continue
-			}
-
-			if w := s[lastIndex:i]; w != "" {
-				words = append(words, w)
-			}
-			lastIndex = i
-		}
+func (c *Caser) Lower(s Str) Str {
+	return s.ToLowerIn(c.locale)
+}
+
+func (c *Caser) Pascal(s Str) Str {
+	return c.pascal(s, c.locale)
+}
+
+func (c *Caser) Snake(s Str) Str {
+	return c.Lower(Str("_").Join(c.words(string(s))))
+}
+
+func (c *Caser) Upper(s Str) Str {
+	return s.ToUpperIn(c.locale)
+}
+
+func (c *Caser) UpperSnake(s Str) Str {
+	return c.Upper(c.Snake(s))
+}
+
+func (c *Caser) lowerFirst(s Str, tag language.Tag) Str {
+	if first, remaining := s.PopStart(); first != -1 {
+		return Str(first).ToLowerIn(tag) + remaining
+	}
+	return s
+}
+
+func (c *Caser) upperFirst(s Str, tag language.Tag) Str {
+	if first, remaining := s.PopStart(); first != -1 {
+		return Str(first).ToUpperIn(tag) + remaining
 	}
-	if w := s[lastIndex:]; w != "" {
-		words = append(words, w)
+	return s
+}
+
+func (c *Caser) pascal(s Str, tag language.Tag) Str {
+	words := c.words(string(s))
+	title := cases.Title(tag)
+	lower := cases.Lower(tag)
+	for i, w := range words {
+		words[i] = title.String(lower.String(w))
 	}
+	return Str(strings.Join(words, ""))
+}
 
-	return words
+func (c *Caser) words(s string) []string {
+	return identifierWords(s, c.separator, c.letterDigit, c.digitLetter)
+}
+
+func isWordSeparator(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r)
+}
+
+func splitWords(s string) []string {
+	return defaultCaser.words(s)
 }
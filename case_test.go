@@ -2,6 +2,9 @@ package str
 
 import (
 	"testing"
+
+	"github.com/go-openapi/inflect"
+	"golang.org/x/text/language"
 )
 
 func TestSplitWords(t *testing.T) {
@@ -71,3 +74,151 @@ func TestSplitWords(t *testing.T) {
 		})
 	}
 }
+
+func TestCaser(t *testing.T) {
+	tests := []struct {
+		name     string
+		caser    *Caser
+		convert  func(*Caser, Str) Str
+		input    Str
+		expected string
+	}{
+		{
+			name:     "custom acronym go pascal",
+			caser:    NewCaser(WithAcronyms("gRPC", "K8S")),
+			convert:  (*Caser).GoPascal,
+			input:    "grpc_k8s_client",
+			expected: "GRPCK8SClient",
+		},
+		{
+			name:     "removed acronym",
+			caser:    NewCaser(WithoutAcronyms("ID")),
+			convert:  (*Caser).GoPascal,
+			input:    "user_id",
+			expected: "UserId",
+		},
+		{
+			name:     "no acronyms",
+			caser:    NewCaser(WithoutAcronyms()),
+			convert:  (*Caser).GoCamel,
+			input:    "http_url",
+			expected: "httpUrl",
+		},
+		{
+			name:     "custom separator",
+			caser:    NewCaser(WithSeparator(func(r rune) bool { return r == '.' })),
+			convert:  (*Caser).Snake,
+			input:    "a.b_c",
+			expected: "a_b_c",
+		},
+		{
+			name:     "plural acronym go pascal",
+			caser:    NewCaser(),
+			convert:  (*Caser).GoPascal,
+			input:    "user_ids",
+			expected: "UserIDs",
+		},
+		{
+			name:     "hiragana snake",
+			caser:    NewCaser(),
+			convert:  (*Caser).Snake,
+			input:    "ひらがな",
+			expected: "ひらがな",
+		},
+		{
+			name:     "kanji kebab",
+			caser:    NewCaser(),
+			convert:  (*Caser).Kebab,
+			input:    "日本語",
+			expected: "日本語",
+		},
+		{
+			name:     "latin and kana snake",
+			caser:    NewCaser(),
+			convert:  (*Caser).Snake,
+			input:    "userの名前",
+			expected: "userの名前",
+		},
+		{
+			name:     "non-latin go pascal",
+			caser:    NewCaser(),
+			convert:  (*Caser).GoPascal,
+			input:    "Ο Δρόμος 日本語",
+			expected: "ΟΔρόμος日本語",
+		},
+		{
+			name:     "locale go pascal",
+			caser:    NewCaser(WithLocale(language.Turkish)),
+			convert:  (*Caser).GoPascal,
+			input:    "iyi gün",
+			expected: "İyiGün",
+		},
+		{
+			name:     "acronym then word snake",
+			caser:    NewCaser(),
+			convert:  (*Caser).Snake,
+			input:    "HTTPServerURLs",
+			expected: "http_server_urls",
+		},
+		{
+			name:     "plural acronym then word snake",
+			caser:    NewCaser(),
+			convert:  (*Caser).Snake,
+			input:    "userIDsList",
+			expected: "user_ids_list",
+		},
+		{
+			name:     "kebab",
+			caser:    NewCaser(),
+			convert:  (*Caser).Kebab,
+			input:    "HelloWorld",
+			expected: "hello-world",
+		},
+		{
+			name:     "upper snake",
+			caser:    NewCaser(),
+			convert:  (*Caser).UpperSnake,
+			input:    "helloWorld",
+			expected: "HELLO_WORLD",
+		},
+		{
+			name:     "camel",
+			caser:    NewCaser(),
+			convert:  (*Caser).Camel,
+			input:    "Hello world",
+			expected: "helloWorld",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.convert(tt.caser, tt.input); got != Str(tt.expected) {
+				t.Errorf("Caser(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCaserAddAcronymsConcurrent(t *testing.T) {
+	rules := inflect.NewDefaultRuleset()
+	c, other := NewCaser(WithRuleset(rules)), NewCaser(WithRuleset(rules))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			c.AddAcronyms("DB")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		c.GoPascal("user_db")
+		other.AddAcronyms("SKU")
+	}
+	<-done
+
+	if got := c.GoPascal("user_db"); got != "UserDB" {
+		t.Errorf("Caser.GoPascal() = %v, want %v", got, "UserDB")
+	}
+	if got := Str("user_db").ToGoPascal(); got != "UserDb" {
+		t.Errorf("Str.ToGoPascal() = %v, want %v", got, "UserDb")
+	}
+}
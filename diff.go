@@ -0,0 +1,504 @@
+package str
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DiffOp is the kind of an Edit.
+type DiffOp uint8
+
+const (
+	DiffEqual DiffOp = iota
+	DiffDelete
+	DiffInsert
+)
+
+func (op DiffOp) String() string {
+	switch op {
+	case DiffEqual:
+		return "equal"
+	case DiffDelete:
+		return "delete"
+	case DiffInsert:
+		return "insert"
+	}
+	return "DiffOp(" + strconv.Itoa(int(op)) + ")"
+}
+
+// Edit is a run of text kept, deleted from the old string or inserted from the
+// new one.
+type Edit struct {
+	Op   DiffOp
+	Text Str
+}
+
+// ErrPatchConflict is returned by ApplyPatch when a hunk does not match the
+// text it is applied to.
+var ErrPatchConflict = errors.New("str: patch does not apply")
+
+// DiffLines returns the edits turning s into other line by line. Lines keep
+// their "\n" terminator, so joining the Equal and Delete texts yields s and
+// joining the Equal and Insert texts yields other.
+func (s Str) DiffLines(other string) []Edit {
+	a, b := splitLines(string(s)), splitLines(other)
+	return mergeEdits(diffUnits(a, b, a, b))
+}
+
+// DiffWords returns the edits turning s into other word by word, with words
+// as split by Fields. Each word carries the whitespace that follows it, and
+// changes in whitespace alone are ignored: Equal edits hold the text of other.
+func (s Str) DiffWords(other string) []Edit {
+	a, aKeys := wordUnits(string(s))
+	b, bKeys := wordUnits(other)
+	return mergeEdits(diffUnits(a, b, aKeys, bKeys))
+}
+
+// DiffRunes returns the edits turning s into other rune by rune.
+func (s Str) DiffRunes(other string) []Edit {
+	a, b := runeStrings(string(s)), runeStrings(other)
+	return mergeEdits(diffUnits(a, b, a, b))
+}
+
+// DiffInline renders the word diff of s and other with deletions marked as
+// [-removed-] and insertions as {+added+}.
+func (s Str) DiffInline(other string) Str {
+	return FormatInline(s.DiffWords(other))
+}
+
+// UnifiedDiff renders the line diff of s and other in unified format with
+// three lines of context.
+func (s Str) UnifiedDiff(other string) Str {
+	return Str(s.Patch(other).String())
+}
+
+// FormatInline renders edits with deletions marked as [-removed-] and
+// insertions as {+added+}. Whitespace that ends a changed run is written
+// outside of the markers.
+func FormatInline(edits []Edit) Str {
+	var b strings.Builder
+	for i, e := range edits {
+		if e.Op == DiffEqual {
+			b.WriteString(string(e.Text))
+			continue
+		}
+
+		text, space := string(e.Text), ""
+		if trimmed := strings.TrimRightFunc(text, unicode.IsSpace); trimmed != "" {
+			text, space = trimmed, text[len(trimmed):]
+		}
+		if e.Op == DiffDelete {
+			b.WriteString("[-" + text + "-]")
+			if i+1 < len(edits) && edits[i+1].Op == DiffInsert {
+				space = ""
+			}
+		} else {
+			b.WriteString("{+" + text + "+}")
+		}
+		b.WriteString(space)
+	}
+	return Str(b.String())
+}
+
+// splitLines splits s after each "\n".
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// wordUnits returns the words of s with the whitespace following them, the
+// first one also holding any leading whitespace, along with the bare words.
+func wordUnits(s string) (units, keys []string) {
+	start := 0
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if unicode.IsSpace(r) {
+			i += size
+			continue
+		}
+
+		end := i
+		for end < len(s) {
+			r, size := utf8.DecodeRuneInString(s[end:])
+			if unicode.IsSpace(r) {
+				break
+			}
+			end += size
+		}
+		next := end
+		for next < len(s) {
+			r, size := utf8.DecodeRuneInString(s[next:])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			next += size
+		}
+		units = append(units, s[start:next])
+		keys = append(keys, s[i:end])
+		start, i = next, next
+	}
+	if start < len(s) {
+		units = append(units, s[start:])
+		keys = append(keys, "")
+	}
+	return units, keys
+}
+
+// diffUnits diffs a and b by their keys and returns one edit per unit.
+func diffUnits(a, b, aKeys, bKeys []string) []Edit {
+	var edits []Edit
+	i, j := 0, 0
+	for _, op := range myers(aKeys, bKeys) {
+		switch op {
+		case DiffEqual:
+			edits = append(edits, Edit{DiffEqual, Str(b[j])})
+			i++
+			j++
+		case DiffDelete:
+			edits = append(edits, Edit{DiffDelete, Str(a[i])})
+			i++
+		case DiffInsert:
+			edits = append(edits, Edit{DiffInsert, Str(b[j])})
+			j++
+		}
+	}
+	return edits
+}
+
+func mergeEdits(edits []Edit) []Edit {
+	var out []Edit
+	for _, e := range edits {
+		if n := len(out); n > 0 && out[n-1].Op == e.Op {
+			out[n-1].Text += e.Text
+		} else {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// myers returns a shortest edit script turning a into b, one op per unit,
+// using the O((N+M)D) time, linear space algorithm of Eugene Myers.
+func myers(a, b []string) []DiffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]DiffOp, prefix, len(a)+len(b))
+	ops = append(ops, myersMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	return append(ops, make([]DiffOp, suffix)...)
+}
+
+func myersMiddle(a, b []string) []DiffOp {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		ops := slices.Repeat([]DiffOp{DiffDelete}, n)
+		return append(ops, slices.Repeat([]DiffOp{DiffInsert}, m)...)
+	}
+
+	// Rather than keeping a trace of the search to backtrack through, which
+	// takes O((N+M)D) space, find a point on a shortest path and diff the
+	// two halves on either side of it.
+	x, y, ok := myersSplit(a, b)
+	if !ok {
+		ops := slices.Repeat([]DiffOp{DiffDelete}, n)
+		return append(ops, slices.Repeat([]DiffOp{DiffInsert}, m)...)
+	}
+	return append(myers(a[:x], b[:y]), myers(a[x:], b[y:])...)
+}
+
+// myersSplit searches for a shortest edit path from both ends of a and b at
+// once and returns the point where the two searches first overlap, which
+// lies on such a path. It reports false if a and b have nothing in common.
+func myersSplit(a, b []string) (x, y int, ok bool) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD
+
+	// vf[offset+k] is the furthest x reached on diagonal k by the forward
+	// search, vb[offset+k] the furthest distance from the end reached on
+	// diagonal k of the reversed texts, or -1 if not reached yet.
+	vf := slices.Repeat([]int{-1}, 2*maxD+2)
+	vb := slices.Repeat([]int{-1}, 2*maxD+2)
+	vf[offset+1], vb[offset+1] = 0, 0
+
+	delta := n - m
+	odd := delta%2 != 0
+	// Diagonals running off the grid are no longer extended.
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			x := vf[offset+k-1] + 1
+			if k == -d || (k != d && vf[offset+k-1] < vf[offset+k+1]) {
+				x = vf[offset+k+1]
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			vf[offset+k] = x
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case odd:
+				if kb := offset + delta - k; kb >= 0 && kb < len(vb) && vb[kb] != -1 && x >= n-vb[kb] {
+					return x, y, true
+				}
+			}
+		}
+
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			x := vb[offset+k-1] + 1
+			if k == -d || (k != d && vb[offset+k-1] < vb[offset+k+1]) {
+				x = vb[offset+k+1]
+			}
+			y := x - k
+			for x < n && y < m && a[n-1-x] == b[m-1-y] {
+				x++
+				y++
+			}
+			vb[offset+k] = x
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !odd:
+				if kf := delta - k; offset+kf >= 0 && offset+kf < len(vf) && vf[offset+kf] != -1 && vf[offset+kf] >= n-x {
+					return vf[offset+kf], vf[offset+kf] - kf, true
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// Patch is a line diff split into hunks, as in the unified diff format.
+type Patch struct {
+	OldName, NewName string
+	Hunks            []Hunk
+}
+
+// Hunk is a group of changed lines and their context. Start lines are
+// 1-based; each edit holds a single line.
+type Hunk struct {
+	OldStart, OldLines int
+	NewStart, NewLines int
+	Edits              []Edit
+}
+
+const patchContext = 3
+
+// Patch returns the changes from s to other as hunks with three lines of
+// context, named "a" and "b".
+func (s Str) Patch(other string) *Patch {
+	a, b := splitLines(string(s)), splitLines(other)
+	edits := diffUnits(a, b, a, b)
+	p := &Patch{OldName: "a", NewName: "b"}
+
+	oldLine, newLine := make([]int, len(edits)+1), make([]int, len(edits)+1)
+	for i, e := range edits {
+		oldLine[i+1], newLine[i+1] = oldLine[i], newLine[i]
+		if e.Op != DiffInsert {
+			oldLine[i+1]++
+		}
+		if e.Op != DiffDelete {
+			newLine[i+1]++
+		}
+	}
+
+	for i := 0; i < len(edits); {
+		if edits[i].Op == DiffEqual {
+			i++
+			continue
+		}
+
+		start, end := max(i-patchContext, 0), i
+		for end < len(edits) {
+			if edits[end].Op != DiffEqual {
+				end++
+				continue
+			}
+			run := end
+			for run < len(edits) && edits[run].Op == DiffEqual {
+				run++
+			}
+			if run == len(edits) || run-end > 2*patchContext {
+				end = min(end+patchContext, run)
+				break
+			}
+			end = run
+		}
+
+		h := Hunk{
+			OldStart: oldLine[start] + 1,
+			OldLines: oldLine[end] - oldLine[start],
+			NewStart: newLine[start] + 1,
+			NewLines: newLine[end] - newLine[start],
+			Edits:    edits[start:end],
+		}
+		if h.OldLines == 0 {
+			h.OldStart--
+		}
+		if h.NewLines == 0 {
+			h.NewStart--
+		}
+		p.Hunks = append(p.Hunks, h)
+		i = end
+	}
+	return p
+}
+
+// String renders p in unified diff format, or "" if it has no hunks.
+func (p *Patch) String() string {
+	if len(p.Hunks) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("--- " + p.OldName + "\n+++ " + p.NewName + "\n")
+	for _, h := range p.Hunks {
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(h.OldStart, h.OldLines), hunkRange(h.NewStart, h.NewLines))
+		for _, e := range h.Edits {
+			b.WriteByte(" -+"[e.Op])
+			b.WriteString(string(e.Text))
+			if !e.Text.HasSuffix("\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+func hunkRange(start, lines int) string {
+	if lines == 1 {
+		return strconv.Itoa(start)
+	}
+	return strconv.Itoa(start) + "," + strconv.Itoa(lines)
+}
+
+var hunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// ParsePatch parses a patch in unified diff format. Lines before the first
+// "---" header are ignored.
+func ParsePatch(text Str) (*Patch, error) {
+	p := &Patch{}
+	lines := splitLines(string(text))
+	i := 0
+	for i < len(lines) && !strings.HasPrefix(lines[i], "--- ") {
+		i++
+	}
+	if i < len(lines) {
+		p.OldName = strings.TrimSuffix(lines[i][4:], "\n")
+		i++
+		if i == len(lines) || !strings.HasPrefix(lines[i], "+++ ") {
+			return nil, fmt.Errorf("str: patch line %d: missing +++ header", i+1)
+		}
+		p.NewName = strings.TrimSuffix(lines[i][4:], "\n")
+		i++
+	}
+
+	for i < len(lines) {
+		m := hunkHeader.FindStringSubmatch(lines[i])
+		if m == nil {
+			return nil, fmt.Errorf("str: patch line %d: malformed hunk header", i+1)
+		}
+		h := Hunk{OldLines: 1, NewLines: 1}
+		h.OldStart, _ = strconv.Atoi(m[1])
+		h.NewStart, _ = strconv.Atoi(m[3])
+		if m[2] != "" {
+			h.OldLines, _ = strconv.Atoi(m[2])
+		}
+		if m[4] != "" {
+			h.NewLines, _ = strconv.Atoi(m[4])
+		}
+		i++
+
+		oldLines, newLines := 0, 0
+		for i < len(lines) && (oldLines < h.OldLines || newLines < h.NewLines || strings.HasPrefix(lines[i], `\`)) {
+			line := lines[i]
+			switch line[0] {
+			case ' ':
+				h.Edits = append(h.Edits, Edit{DiffEqual, Str(line[1:])})
+				oldLines++
+				newLines++
+			case '-':
+				h.Edits = append(h.Edits, Edit{DiffDelete, Str(line[1:])})
+				oldLines++
+			case '+':
+				h.Edits = append(h.Edits, Edit{DiffInsert, Str(line[1:])})
+				newLines++
+			case '\\':
+				if len(h.Edits) == 0 {
+					return nil, fmt.Errorf("str: patch line %d: unexpected %q", i+1, strings.TrimSuffix(line, "\n"))
+				}
+				last := &h.Edits[len(h.Edits)-1]
+				last.Text = last.Text.TrimSuffix("\n")
+			default:
+				return nil, fmt.Errorf("str: patch line %d: unexpected %q", i+1, strings.TrimSuffix(line, "\n"))
+			}
+			i++
+		}
+		if oldLines != h.OldLines || newLines != h.NewLines {
+			return nil, fmt.Errorf("str: patch hunk @@ -%s +%s @@: truncated", hunkRange(h.OldStart, h.OldLines), hunkRange(h.NewStart, h.NewLines))
+		}
+		p.Hunks = append(p.Hunks, h)
+	}
+	return p, nil
+}
+
+// ApplyPatch applies p to s. It returns an error wrapping ErrPatchConflict if
+// the context or deleted lines of a hunk do not match s.
+func (s Str) ApplyPatch(p *Patch) (Str, error) {
+	lines := splitLines(string(s))
+	var b strings.Builder
+	pos := 0
+	for n, h := range p.Hunks {
+		start := h.OldStart - 1
+		if h.OldLines == 0 {
+			start++
+		}
+		if start < pos || start > len(lines) {
+			return s, fmt.Errorf("%w: hunk %d starts at line %d", ErrPatchConflict, n+1, h.OldStart)
+		}
+		for _, line := range lines[pos:start] {
+			b.WriteString(line)
+		}
+		pos = start
+
+		for _, e := range h.Edits {
+			if e.Op == DiffInsert {
+				b.WriteString(string(e.Text))
+				continue
+			}
+			if pos == len(lines) || lines[pos] != string(e.Text) {
+				return s, fmt.Errorf("%w: hunk %d does not match line %d", ErrPatchConflict, n+1, pos+1)
+			}
+			if e.Op == DiffEqual {
+				b.WriteString(lines[pos])
+			}
+			pos++
+		}
+	}
+	for _, line := range lines[pos:] {
+		b.WriteString(line)
+	}
+	return Str(b.String()), nil
+}
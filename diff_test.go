@@ -0,0 +1,195 @@
+package str
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	got := Str("a\nb\nc\n").DiffLines("a\nc\nd\n")
+	expected := []Edit{
+		{DiffEqual, "a\n"},
+		{DiffDelete, "b\n"},
+		{DiffEqual, "c\n"},
+		{DiffInsert, "d\n"},
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Str.DiffLines() = %v, want %v", got, expected)
+	}
+
+	if got := Str("same").DiffLines("same"); !slices.Equal(got, []Edit{{DiffEqual, "same"}}) {
+		t.Errorf("Str.DiffLines() = %v", got)
+	}
+	if got := Str("").DiffLines(""); got != nil {
+		t.Errorf("Str.DiffLines() = %v, want nil", got)
+	}
+}
+
+func TestDiffRunes(t *testing.T) {
+	got := Str("kitten").DiffRunes("sitting")
+	if n := editCost(got); n != 5 {
+		t.Errorf("Str.DiffRunes() = %v, want 5 changed runes", got)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	word := func() string {
+		b := make([]rune, rng.Intn(12))
+		for i := range b {
+			b[i] = []rune("abcé")[rng.Intn(4)]
+		}
+		return string(b)
+	}
+	for range 300 {
+		a, b := Str(word()), word()
+		edits := a.DiffRunes(b)
+		if old, new := reconstruct(edits); old != a || new != Str(b) {
+			t.Fatalf("Str(%q).DiffRunes(%q) = %v does not reconstruct the inputs", a, b, edits)
+		}
+
+		// A shortest edit script keeps a longest common subsequence.
+		keep := 0
+		for _, e := range edits {
+			if e.Op == DiffEqual {
+				keep += e.Text.RuneCount()
+			}
+		}
+		if lcs := a.LongestCommonSubsequence(b).RuneCount(); keep != lcs {
+			t.Fatalf("Str(%q).DiffRunes(%q) keeps %d runes, want %d", a, b, keep, lcs)
+		}
+	}
+}
+
+func editCost(edits []Edit) int {
+	n := 0
+	for _, e := range edits {
+		if e.Op != DiffEqual {
+			n += e.Text.RuneCount()
+		}
+	}
+	return n
+}
+
+func reconstruct(edits []Edit) (old, new Str) {
+	for _, e := range edits {
+		if e.Op != DiffInsert {
+			old += e.Text
+		}
+		if e.Op != DiffDelete {
+			new += e.Text
+		}
+	}
+	return old, new
+}
+
+func TestDiffWords(t *testing.T) {
+	got := Str("the quick  brown fox").DiffWords("the slow brown fox jumps")
+	expected := []Edit{
+		{DiffEqual, "the "},
+		{DiffDelete, "quick  "},
+		{DiffInsert, "slow "},
+		{DiffEqual, "brown fox "},
+		{DiffInsert, "jumps"},
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Str.DiffWords() = %q, want %q", got, expected)
+	}
+}
+
+func TestDiffInline(t *testing.T) {
+	tests := []struct {
+		a, b     Str
+		expected Str
+	}{
+		{"the quick brown fox", "the slow brown fox", "the [-quick-]{+slow+} brown fox"},
+		{"a b c", "a c", "a [-b-] c"},
+		{"a c", "a b c", "a {+b+} c"},
+		{"same", "same", "same"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.DiffInline(string(tt.b)); got != tt.expected {
+			t.Errorf("Str(%q).DiffInline(%q) = %q, want %q", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	old := Str("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n")
+	new := "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n15\nsixteen"
+	expected := `--- a
++++ b
+@@ -1,6 +1,6 @@
+ 1
+ 2
+-3
++three
+ 4
+ 5
+ 6
+@@ -11,5 +11,5 @@
+ 11
+ 12
+ 13
+-14
+ 15
++sixteen
+\ No newline at end of file
+`
+	if got := old.UnifiedDiff(new); got != Str(expected) {
+		t.Errorf("Str.UnifiedDiff() =\n%s\nwant\n%s", got, expected)
+	}
+	if got := old.UnifiedDiff(string(old)); got != "" {
+		t.Errorf("Str.UnifiedDiff() = %q, want empty", got)
+	}
+	if got := Str("").UnifiedDiff("x\n"); got != "--- a\n+++ b\n@@ -0,0 +1 @@\n+x\n" {
+		t.Errorf("Str.UnifiedDiff() = %q", got)
+	}
+}
+
+func TestPatchRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	text := func() string {
+		lines := make([]string, rng.Intn(30))
+		for i := range lines {
+			lines[i] = string(rune('a' + rng.Intn(4)))
+		}
+		s := strings.Join(lines, "\n")
+		if rng.Intn(2) == 0 && s != "" {
+			s += "\n"
+		}
+		return s
+	}
+
+	for range 300 {
+		a, b := Str(text()), text()
+		p, err := ParsePatch(a.UnifiedDiff(b))
+		if err != nil {
+			t.Fatalf("ParsePatch() error = %v for %q -> %q", err, a, b)
+		}
+		got, err := a.ApplyPatch(p)
+		if err != nil || got != Str(b) {
+			t.Fatalf("Str(%q).ApplyPatch() = %q, %v, want %q", a, got, err, b)
+		}
+	}
+}
+
+func TestApplyPatchConflict(t *testing.T) {
+	p := Str("a\nb\nc\n").Patch("a\nB\nc\n")
+	if got, err := Str("a\nx\nc\n").ApplyPatch(p); !errors.Is(err, ErrPatchConflict) || got != "a\nx\nc\n" {
+		t.Errorf("Str.ApplyPatch() = %q, %v, want ErrPatchConflict", got, err)
+	}
+
+	for _, text := range []Str{
+		"--- a\n",
+		"--- a\n+++ b\n@@ bad @@\n",
+		"--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n",
+		"--- a\n+++ b\n@@ -1 +1 @@\n*a\n",
+	} {
+		if _, err := ParsePatch(text); err == nil {
+			t.Errorf("ParsePatch(%q) error = nil", text)
+		}
+	}
+}
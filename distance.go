@@ -0,0 +1,284 @@
+package str
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+type distanceOptions struct {
+	graphemes bool
+}
+
+// DistanceOption configures the similarity and edit-distance methods.
+type DistanceOption func(*distanceOptions)
+
+// WithGraphemes compares grapheme clusters instead of runes, so that "é"
+// written with a combining accent counts as a single edit.
+func WithGraphemes() DistanceOption {
+	return func(o *distanceOptions) {
+		o.graphemes = true
+	}
+}
+
+// distanceUnits splits a and b into the units selected by opts and calls fn
+// with them.
+func distanceUnits[R any](a, b string, opts []DistanceOption, fn func(a, b []string) R) R {
+	var o distanceOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.graphemes {
+		return fn(graphemes(a), graphemes(b))
+	}
+	return fn(runeStrings(a), runeStrings(b))
+}
+
+func runeStrings(s string) []string {
+	out := make([]string, 0, len(s))
+	for s != "" {
+		_, n := utf8.DecodeRuneInString(s)
+		out = append(out, s[:n])
+		s = s[n:]
+	}
+	return out
+}
+
+// Levenshtein returns the minimum number of insertions, deletions and
+// substitutions turning s into other.
+func (s Str) Levenshtein(other string, opts ...DistanceOption) int {
+	return distanceUnits(string(s), other, opts, levenshtein)
+}
+
+// DamerauLevenshtein is like Levenshtein but also counts the transposition of
+// two adjacent units as a single edit.
+func (s Str) DamerauLevenshtein(other string, opts ...DistanceOption) int {
+	return distanceUnits(string(s), other, opts, damerauLevenshtein)
+}
+
+// Hamming returns the number of positions at which s and other differ, or -1
+// if they differ in length.
+func (s Str) Hamming(other string, opts ...DistanceOption) int {
+	return distanceUnits(string(s), other, opts, func(a, b []string) int {
+		if len(a) != len(b) {
+			return -1
+		}
+		d := 0
+		for i := range a {
+			if a[i] != b[i] {
+				d++
+			}
+		}
+		return d
+	})
+}
+
+// Jaro returns the Jaro similarity of s and other, from 0 for no similarity
+// to 1 for equal strings.
+func (s Str) Jaro(other string, opts ...DistanceOption) float64 {
+	return distanceUnits(string(s), other, opts, jaro)
+}
+
+// JaroWinkler is like Jaro but favours strings sharing a prefix of up to four
+// units, which suits short strings such as names and commands.
+func (s Str) JaroWinkler(other string, opts ...DistanceOption) float64 {
+	return distanceUnits(string(s), other, opts, jaroWinkler)
+}
+
+func (s Str) LongestCommonSubsequence(other string, opts ...DistanceOption) Str {
+	return distanceUnits(string(s), other, opts, func(a, b []string) Str {
+		return Str(longestCommonSubsequence(a, b))
+	})
+}
+
+// Similarity returns 1 minus the Levenshtein distance of s and other divided by
+// the length of the longer one, so 1 means equal and 0 entirely different.
+func (s Str) Similarity(other string, opts ...DistanceOption) float64 {
+	return distanceUnits(string(s), other, opts, func(a, b []string) float64 {
+		n := max(len(a), len(b))
+		if n == 0 {
+			return 1
+		}
+		return 1 - float64(levenshtein(a, b))/float64(n)
+	})
+}
+
+// ClosestTo returns the elements of s within maxDist Levenshtein edits of
+// target, closest first. Ties are ranked by JaroWinkler similarity and then
+// keep their order in s.
+func (s Array) ClosestTo(target string, maxDist int, opts ...DistanceOption) Array {
+	type candidate struct {
+		value Str
+		dist  int
+		sim   float64
+	}
+
+	var candidates []candidate
+	for _, v := range s {
+		if d := v.Levenshtein(target, opts...); d <= maxDist {
+			candidates = append(candidates, candidate{v, d, v.JaroWinkler(target, opts...)})
+		}
+	}
+	slices.SortStableFunc(candidates, func(x, y candidate) int {
+		switch {
+		case x.dist != y.dist:
+			return x.dist - y.dist
+		case x.sim > y.sim:
+			return -1
+		case x.sim < y.sim:
+			return 1
+		}
+		return 0
+	})
+
+	out := make(Array, len(candidates))
+	for i := range candidates {
+		out[i] = candidates[i].value
+	}
+	return out
+}
+
+func levenshtein[T comparable](a, b []T) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := range a {
+		cur[0] = i + 1
+		for j := range b {
+			cost := 1
+			if a[i] == b[j] {
+				cost = 0
+			}
+			cur[j+1] = min(prev[j]+cost, prev[j+1]+1, cur[j]+1)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// damerauLevenshtein computes the unrestricted Damerau-Levenshtein distance,
+// where a transposed pair may be edited further, using the algorithm of
+// Lowrance and Wagner.
+func damerauLevenshtein[T comparable](a, b []T) int {
+	inf := len(a) + len(b)
+	d := make([][]int, len(a)+2)
+	for i := range d {
+		d[i] = make([]int, len(b)+2)
+		d[i][0] = inf
+		if i > 0 {
+			d[i][1] = i - 1
+		}
+	}
+	for j := 1; j < len(b)+2; j++ {
+		d[0][j] = inf
+		d[1][j] = j - 1
+	}
+
+	last := make(map[T]int)
+	for i := 1; i <= len(a); i++ {
+		match := 0
+		for j := 1; j <= len(b); j++ {
+			k, l := last[b[j-1]], match
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost, match = 0, j
+			}
+			d[i+1][j+1] = min(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[k][l]+(i-k-1)+1+(j-l-1),
+			)
+		}
+		last[a[i-1]] = i
+	}
+	return d[len(a)+1][len(b)+1]
+}
+
+func jaro[T comparable](a, b []T) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	window := max(max(len(a), len(b))/2-1, 0)
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		for j := max(i-window, 0); j < min(i+window+1, len(b)); j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions/2))/m) / 3
+}
+
+func jaroWinkler[T comparable](a, b []T) float64 {
+	sim := jaro(a, b)
+	if sim <= 0.7 {
+		return sim
+	}
+
+	prefix := 0
+	for prefix < min(len(a), len(b), 4) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return sim + float64(prefix)*0.1*(1-sim)
+}
+
+func longestCommonSubsequence(a, b []string) string {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	var out []byte
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, a[i]...)
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return string(out)
+}
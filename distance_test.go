@@ -0,0 +1,133 @@
+package str
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b        Str
+		levenshtein int
+		damerau     int
+		hamming     int
+	}{
+		{"", "", 0, 0, 0},
+		{"abc", "", 3, 3, -1},
+		{"kitten", "sitting", 3, 3, -1},
+		{"flaw", "lawn", 2, 2, 4},
+		{"ca", "ac", 2, 1, 2},
+		{"ca", "abc", 3, 2, -1},
+		{"karolin", "kathrin", 3, 3, 3},
+		{"héllo", "hallo", 1, 1, 1},
+		{"日本語", "日本", 1, 1, -1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Levenshtein(string(tt.b)); got != tt.levenshtein {
+			t.Errorf("Str(%q).Levenshtein(%q) = %d, want %d", tt.a, tt.b, got, tt.levenshtein)
+		}
+		if got := tt.a.DamerauLevenshtein(string(tt.b)); got != tt.damerau {
+			t.Errorf("Str(%q).DamerauLevenshtein(%q) = %d, want %d", tt.a, tt.b, got, tt.damerau)
+		}
+		if got := tt.a.Hamming(string(tt.b)); got != tt.hamming {
+			t.Errorf("Str(%q).Hamming(%q) = %d, want %d", tt.a, tt.b, got, tt.hamming)
+		}
+	}
+}
+
+func TestEditDistanceGraphemes(t *testing.T) {
+	a, b := Str("café"), "cafe"
+	if got := a.Levenshtein(b); got != 1 {
+		t.Errorf("Str.Levenshtein() = %d, want 1", got)
+	}
+	if got := a.Levenshtein("cafè", WithGraphemes()); got != 1 {
+		t.Errorf("Str.Levenshtein(WithGraphemes) = %d, want 1", got)
+	}
+	if got := a.Hamming("cafè"); got != -1 {
+		t.Errorf("Str.Hamming() = %d, want -1", got)
+	}
+	if got := a.Hamming("cafè", WithGraphemes()); got != 1 {
+		t.Errorf("Str.Hamming(WithGraphemes) = %d, want 1", got)
+	}
+}
+
+func TestJaro(t *testing.T) {
+	tests := []struct {
+		a, b    Str
+		jaro    float64
+		winkler float64
+	}{
+		{"", "", 1, 1},
+		{"abc", "", 0, 0},
+		{"martha", "marhta", 0.944444, 0.961111},
+		{"dixon", "dicksonx", 0.766667, 0.813333},
+		{"jellyfish", "smellyfish", 0.896296, 0.896296},
+		{"abc", "xyz", 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Jaro(string(tt.b)); math.Abs(got-tt.jaro) > 1e-6 {
+			t.Errorf("Str(%q).Jaro(%q) = %f, want %f", tt.a, tt.b, got, tt.jaro)
+		}
+		if got := tt.a.JaroWinkler(string(tt.b)); math.Abs(got-tt.winkler) > 1e-6 {
+			t.Errorf("Str(%q).JaroWinkler(%q) = %f, want %f", tt.a, tt.b, got, tt.winkler)
+		}
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	tests := []struct {
+		a, b, expected Str
+	}{
+		{"ABCBDAB", "BDCABA", "BDAB"},
+		{"abc", "xyz", ""},
+		{"", "abc", ""},
+		{"日本語です", "本です", "本です"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.LongestCommonSubsequence(string(tt.b)); got != tt.expected {
+			t.Errorf("Str(%q).LongestCommonSubsequence(%q) = %q, want %q", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b     Str
+		expected float64
+	}{
+		{"", "", 1},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+		{"kitten", "sitting", 1 - 3.0/7},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Similarity(string(tt.b)); math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("Str(%q).Similarity(%q) = %f, want %f", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+func TestClosestTo(t *testing.T) {
+	commands := Array{"status", "stash", "commit", "checkout", "cherry-pick", "stage"}
+
+	tests := []struct {
+		input    string
+		maxDist  int
+		expected Array
+	}{
+		{"stats", 2, Array{"status", "stash", "stage"}},
+		{"comit", 1, Array{"commit"}},
+		{"xyz", 2, Array{}},
+	}
+
+	for _, tt := range tests {
+		if got := commands.ClosestTo(tt.input, tt.maxDist); !slices.Equal(got, tt.expected) {
+			t.Errorf("Array.ClosestTo(%q, %d) = %q, want %q", tt.input, tt.maxDist, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The Fold methods match under Unicode simple case folding, rune by rune, so
+// byte offsets refer to s itself. Unlike EqualFold they do not expand "ß" to
+// "ss".
+
+func (s Str) ContainsFold(values ...string) bool {
+	for i := range values {
+		if indexFold(string(s), values[i]) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Str) CountFold(search string) int {
+	if search == "" {
+		return s.Count(search)
+	}
+	count := 0
+	for rest := string(s); ; count++ {
+		i := indexFold(rest, search)
+		if i < 0 {
+			return count
+		}
+		n, _ := prefixFold(rest[i:], search)
+		rest = rest[i+n:]
+	}
+}
+
+func (s Str) CutFold(search string) (before, after Str, found bool) {
+	if i := indexFold(string(s), search); i >= 0 {
+		n, _ := prefixFold(string(s[i:]), search)
+		return s[:i], s[i+n:], true
+	}
+	return s, "", false
+}
+
+func (s Str) CutPrefixFold(prefix string) (Str, bool) {
+	if n, ok := prefixFold(string(s), prefix); ok {
+		return s[n:], true
+	}
+	return s, false
+}
+
+func (s Str) CutSuffixFold(suffix string) (Str, bool) {
+	if n, ok := suffixFold(string(s), suffix); ok {
+		return s[:len(s)-n], true
+	}
+	return s, false
+}
+
+func (s Str) HasPrefixFold(prefixes ...string) bool {
+	for i := range prefixes {
+		if _, ok := prefixFold(string(s), prefixes[i]); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Str) HasSuffixFold(suffixes ...string) bool {
+	for i := range suffixes {
+		if _, ok := suffixFold(string(s), suffixes[i]); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Str) InFold(values ...string) bool {
+	for i := range values {
+		if strings.EqualFold(string(s), values[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Str) IndexFold(search string) int {
+	return indexFold(string(s), search)
+}
+
+func (s Str) LastIndexFold(search string) int {
+	for i := len(s); i >= 0; {
+		if _, ok := prefixFold(string(s[i:]), search); ok {
+			return i
+		}
+		if i == 0 {
+			break
+		}
+		_, size := utf8.DecodeLastRuneInString(string(s[:i]))
+		i -= size
+	}
+	return -1
+}
+
+func (s Str) ReplaceFold(old, new string) Str {
+	if old == "" {
+		return s.Replace(old, new)
+	}
+	i := indexFold(string(s), old)
+	if i < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	rest := string(s)
+	for ; i >= 0; i = indexFold(rest, old) {
+		n, _ := prefixFold(rest[i:], old)
+		b.WriteString(rest[:i])
+		b.WriteString(new)
+		rest = rest[i+n:]
+	}
+	b.WriteString(rest)
+	return Str(b.String())
+}
+
+func (s Str) TrimPrefixFold(prefixes ...string) Str {
+	for i := range prefixes {
+		s, _ = s.CutPrefixFold(prefixes[i])
+	}
+	return s
+}
+
+func (s Str) TrimSuffixFold(suffixes ...string) Str {
+	for i := range suffixes {
+		s, _ = s.CutSuffixFold(suffixes[i])
+	}
+	return s
+}
+
+// prefixFold reports whether s starts with prefix under simple folding and
+// returns the byte length of the matching part of s.
+func prefixFold(s, prefix string) (int, bool) {
+	n := 0
+	for _, p := range prefix {
+		if n == len(s) {
+			return 0, false
+		}
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if r != p && foldRune(r) != foldRune(p) {
+			return 0, false
+		}
+		n += size
+	}
+	return n, true
+}
+
+// suffixFold is like prefixFold for the end of s.
+func suffixFold(s, suffix string) (int, bool) {
+	n := 0
+	for suffix != "" {
+		if n == len(s) {
+			return 0, false
+		}
+		p, psize := utf8.DecodeLastRuneInString(suffix)
+		r, size := utf8.DecodeLastRuneInString(s[:len(s)-n])
+		if r != p && foldRune(r) != foldRune(p) {
+			return 0, false
+		}
+		suffix = suffix[:len(suffix)-psize]
+		n += size
+	}
+	return n, true
+}
+
+func indexFold(s, search string) int {
+	for i := 0; i <= len(s); {
+		if _, ok := prefixFold(s[i:], search); ok {
+			return i
+		}
+		if i == len(s) {
+			break
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	return -1
+}
+
+// foldRune maps every rune of a simple case folding orbit to the same
+// representative, its smallest member.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		return r
+	}
+	min := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < min {
+			min = f
+		}
+	}
+	return min
+}
@@ -0,0 +1,98 @@
+package str
+
+import "testing"
+
+func TestFold(t *testing.T) {
+	s := Str("Hello WÖRLD, hello wörld")
+
+	if !s.ContainsFold("xyz", "WöRLD") || s.ContainsFold("xyz") {
+		t.Error("Str.ContainsFold() mismatch")
+	}
+	if !s.HasPrefixFold("HELLO") || s.HasPrefixFold("world") {
+		t.Error("Str.HasPrefixFold() mismatch")
+	}
+	if !s.HasSuffixFold("WÖRLD") || s.HasSuffixFold("hello") {
+		t.Error("Str.HasSuffixFold() mismatch")
+	}
+	if got := s.CountFold("wörld"); got != 2 {
+		t.Errorf("Str.CountFold() = %d, want 2", got)
+	}
+	if got := Str("aAa").CountFold(""); got != 4 {
+		t.Errorf("Str.CountFold(\"\") = %d, want 4", got)
+	}
+	if !Str("Gopher").InFold("rust", "GOPHER") || Str("Gopher").InFold("go") {
+		t.Error("Str.InFold() mismatch")
+	}
+}
+
+func TestIndexFold(t *testing.T) {
+	tests := []struct {
+		input  Str
+		search string
+		first  int
+		last   int
+	}{
+		{"Hello WÖRLD, hello wörld", "wörld", 6, 20},
+		{"Hello", "", 0, 5},
+		{"Hello", "xyz", -1, -1},
+		// The Kelvin sign is three bytes long but folds to "k".
+		{"\u212Aelvin kelvin", "KELVIN", 0, 9},
+		{"ſs", "SS", 0, 0},
+		{"", "a", -1, -1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.IndexFold(tt.search); got != tt.first {
+			t.Errorf("Str(%q).IndexFold(%q) = %d, want %d", tt.input, tt.search, got, tt.first)
+		}
+		if got := tt.input.LastIndexFold(tt.search); got != tt.last {
+			t.Errorf("Str(%q).LastIndexFold(%q) = %d, want %d", tt.input, tt.search, got, tt.last)
+		}
+	}
+}
+
+func TestCutFold(t *testing.T) {
+	before, after, found := Str("Content-Type: text/html").CutFold("content-type:")
+	if before != "" || after != " text/html" || !found {
+		t.Errorf("Str.CutFold() = %q, %q, %v", before, after, found)
+	}
+	before, after, found = Str("aKb").CutFold("K")
+	if before != "a" || after != "b" || !found {
+		t.Errorf("Str.CutFold() = %q, %q, %v", before, after, found)
+	}
+	if before, after, found := Str("abc").CutFold("x"); before != "abc" || after != "" || found {
+		t.Errorf("Str.CutFold() = %q, %q, %v", before, after, found)
+	}
+
+	if got, ok := Str("HTTPS://example.com").CutPrefixFold("https://"); got != "example.com" || !ok {
+		t.Errorf("Str.CutPrefixFold() = %q, %v", got, ok)
+	}
+	if got, ok := Str("photo.JPEG").CutSuffixFold(".jpeg"); got != "photo" || !ok {
+		t.Errorf("Str.CutSuffixFold() = %q, %v", got, ok)
+	}
+	if got := Str("Mr. Mrs. Smith").TrimPrefixFold("MR. ", "mrs. "); got != "Smith" {
+		t.Errorf("Str.TrimPrefixFold() = %q", got)
+	}
+	if got := Str("report.TAR.GZ").TrimSuffixFold(".gz", ".tar"); got != "report" {
+		t.Errorf("Str.TrimSuffixFold() = %q", got)
+	}
+}
+
+func TestReplaceFold(t *testing.T) {
+	tests := []struct {
+		input    Str
+		old, new string
+		expected Str
+	}{
+		{"Go go GO gopher", "go", "Rust", "Rust Rust Rust Rustpher"},
+		{"Σίσυφος ΣΊΣΥΦΟΣ", "σίσυφος", "x", "x x"},
+		{"abc", "x", "y", "abc"},
+		{"ab", "", "-", "-a-b-"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.ReplaceFold(tt.old, tt.new); got != tt.expected {
+			t.Errorf("Str(%q).ReplaceFold(%q, %q) = %q, want %q", tt.input, tt.old, tt.new, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+//go:build ignore
+
+// This program generates tables.go from the Unicode Character Database.
+// Run it with "go generate". Use -ucd to read the data files from a local
+// directory instead of unicode.org.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const unicodeVersion = "15.0.0"
+
+var (
+	ucd    = flag.String("ucd", "https://www.unicode.org/Public/"+unicodeVersion+"/ucd", "UCD base URL or directory")
+	output = flag.String("output", "tables.go", "output file")
+)
+
+type table struct {
+	name   string
+	file   string
+	prefix string
+	values []string
+}
+
+var tables = []table{
+	{
+		name:   "wordBreakRanges",
+		file:   "auxiliary/WordBreakProperty.txt",
+		prefix: "wb",
+		values: []string{"ALetter", "CR", "Double_Quote", "Extend", "ExtendNumLet", "Format", "Hebrew_Letter", "Katakana", "LF", "MidLetter", "MidNum", "MidNumLet", "Newline", "Numeric", "Regional_Indicator", "Single_Quote", "WSegSpace", "ZWJ"},
+	},
+	{
+		name:   "graphemeBreakRanges",
+		file:   "auxiliary/GraphemeBreakProperty.txt",
+		prefix: "gb",
+		values: []string{"CR", "Control", "Extend", "L", "LF", "LV", "LVT", "Prepend", "Regional_Indicator", "SpacingMark", "T", "V", "ZWJ"},
+	},
+	{
+		name:   "extPictRanges",
+		file:   "emoji/emoji-data.txt",
+		prefix: "xp",
+		values: []string{"Extended_Pictographic"},
+	},
+	{
+		name:   "emojiPresentationRanges",
+		file:   "emoji/emoji-data.txt",
+		prefix: "ep",
+		values: []string{"Emoji_Presentation"},
+	},
+}
+
+type entry struct {
+	lo, hi rune
+	value  string
+}
+
+func main() {
+	flag.Parse()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_tables.go from Unicode %s. DO NOT EDIT.\n\n", unicodeVersion)
+	fmt.Fprintf(&buf, "package str\n\n")
+	for _, t := range tables {
+		entries := parse(t)
+		fmt.Fprintf(&buf, "const (\n\t%sOther uint8 = iota\n", t.prefix)
+		for _, v := range t.values {
+			fmt.Fprintf(&buf, "\t%s%s\n", t.prefix, constName(v))
+		}
+		fmt.Fprintf(&buf, ")\n\n")
+		fmt.Fprintf(&buf, "// %s is taken from %s.\n", t.name, t.file)
+		fmt.Fprintf(&buf, "var %s = []propertyRange{\n", t.name)
+		for _, e := range entries {
+			fmt.Fprintf(&buf, "\t{0x%04X, 0x%04X, %s%s},\n", e.lo, e.hi, t.prefix, constName(e.value))
+		}
+		fmt.Fprintf(&buf, "}\n\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parse(t table) []entry {
+	r, err := open(t.file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	var entries []entry
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		codes, value, ok := strings.Cut(line, ";")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if !slices.Contains(t.values, value) {
+			continue
+		}
+
+		lo, hi, found := strings.Cut(strings.TrimSpace(codes), "..")
+		if !found {
+			hi = lo
+		}
+		entries = append(entries, entry{lo: hex(lo), hi: hex(hi), value: value})
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int { return int(a.lo - b.lo) })
+	merged := entries[:0]
+	for _, e := range entries {
+		if n := len(merged); n > 0 && merged[n-1].value == e.value && merged[n-1].hi+1 == e.lo {
+			merged[n-1].hi = e.hi
+			continue
+		}
+		merged = append(merged, e)
+	}
+	return merged
+}
+
+func open(name string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(*ucd, "http://") && !strings.HasPrefix(*ucd, "https://") {
+		return os.Open(filepath.Join(*ucd, name))
+	}
+	resp, err := http.Get(*ucd + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", name, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+func hex(s string) rune {
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(v)
+}
+
+func constName(value string) string {
+	return strings.ReplaceAll(value, "_", "")
+}
@@ -0,0 +1,216 @@
+package str
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	pictNone = iota
+	pictSeq
+	pictZWJ
+)
+
+// graphemeLen returns the byte length of the first extended grapheme cluster
+// of s as defined by UAX #29.
+func graphemeLen(s string) int {
+	r, n := utf8.DecodeRuneInString(s)
+	if n == len(s) {
+		return n
+	}
+
+	prev := lookupProperty(graphemeBreakRanges, r)
+	pict, ri := nextPictState(pictNone, prev, r), 0
+	if prev == gbRegionalIndicator {
+		ri = 1
+	}
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		cur := lookupProperty(graphemeBreakRanges, r)
+		if isGraphemeBoundary(prev, cur, pict, ri, r) {
+			break
+		}
+
+		pict = nextPictState(pict, cur, r)
+		if cur == gbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+		prev = cur
+		n += size
+	}
+	return n
+}
+
+func isGraphemeBoundary(prev, cur uint8, pict, ri int, r rune) bool {
+	switch {
+	case prev == gbCR && cur == gbLF:
+		return false
+	case prev == gbControl || prev == gbCR || prev == gbLF:
+		return true
+	case cur == gbControl || cur == gbCR || cur == gbLF:
+		return true
+	case prev == gbL && (cur == gbL || cur == gbV || cur == gbLV || cur == gbLVT):
+		return false
+	case (prev == gbLV || prev == gbV) && (cur == gbV || cur == gbT):
+		return false
+	case (prev == gbLVT || prev == gbT) && cur == gbT:
+		return false
+	case cur == gbExtend || cur == gbZWJ || cur == gbSpacingMark || prev == gbPrepend:
+		return false
+	case pict == pictZWJ && isExtendedPictographic(r):
+		return false
+	case prev == gbRegionalIndicator && cur == gbRegionalIndicator:
+		return ri%2 == 0
+	}
+	return true
+}
+
+// nextPictState tracks the "ExtPict Extend* ZWJ" prefix of an emoji
+// ZWJ sequence.
+func nextPictState(state int, prop uint8, r rune) int {
+	switch {
+	case isExtendedPictographic(r):
+		return pictSeq
+	case prop == gbExtend && state == pictSeq:
+		return pictSeq
+	case prop == gbZWJ && state == pictSeq:
+		return pictZWJ
+	}
+	return pictNone
+}
+
+// graphemeOffsets returns the byte offsets of every grapheme cluster boundary
+// in s, including 0 and len(s).
+func graphemeOffsets(s string) []int {
+	offsets := []int{0}
+	for i := 0; i < len(s); {
+		i += graphemeLen(s[i:])
+		offsets = append(offsets, i)
+	}
+	return offsets
+}
+
+func graphemes(s string) []string {
+	var out []string
+	for s != "" {
+		n := graphemeLen(s)
+		out = append(out, s[:n])
+		s = s[n:]
+	}
+	return out
+}
+
+func (s Str) GraphemeAt(index int) Str {
+	if index < 0 {
+		return ""
+	}
+	for rest := string(s); rest != ""; index-- {
+		n := graphemeLen(rest)
+		if index == 0 {
+			return Str(rest[:n])
+		}
+		rest = rest[n:]
+	}
+	return ""
+}
+
+func (s Str) GraphemeCount() int {
+	count := 0
+	for rest := string(s); rest != ""; count++ {
+		rest = rest[graphemeLen(rest):]
+	}
+	return count
+}
+
+func (s Str) Graphemes() Array {
+	return NewArray(graphemes(string(s)))
+}
+
+func (s Str) PadEndGraphemes(length int, pad string) Str {
+	count := s.GraphemeCount()
+	if count >= length {
+		return s
+	}
+	padLen := Str(pad).GraphemeCount()
+	if padLen == 0 {
+		return s
+	}
+
+	length -= count
+	return s + Str(strings.Repeat(pad, length/padLen)) + Str(pad).SliceGraphemesTo(length%padLen)
+}
+
+func (s Str) PadStartGraphemes(length int, pad string) Str {
+	count := s.GraphemeCount()
+	if count >= length {
+		return s
+	}
+	padLen := Str(pad).GraphemeCount()
+	if padLen == 0 {
+		return s
+	}
+
+	length -= count
+	return Str(strings.Repeat(pad, length/padLen)) + Str(pad).SliceGraphemesTo(length%padLen) + s
+}
+
+func (s Str) PopGraphemeEnd() (Str, Str) {
+	if s == "" {
+		return "", ""
+	}
+	last := 0
+	for i := 0; i < len(s); {
+		last = i
+		i += graphemeLen(string(s[i:]))
+	}
+	return s[last:], s[:last]
+}
+
+func (s Str) PopGraphemeStart() (Str, Str) {
+	n := graphemeLen(string(s))
+	return s[:n], s[n:]
+}
+
+func (s Str) SliceGraphemes(start, end int) Str {
+	offsets := graphemeOffsets(string(s))
+	count := len(offsets) - 1
+	if start < 0 {
+		start = count + start
+	}
+	if end < 0 {
+		end = count + end
+	}
+	if start < 0 || end < 0 || start >= count || end > count || start >= end {
+		return ""
+	}
+
+	return s[offsets[start]:offsets[end]]
+}
+
+func (s Str) SliceGraphemesFrom(index int) Str {
+	offsets := graphemeOffsets(string(s))
+	count := len(offsets) - 1
+	if index < 0 {
+		index = count + index
+	}
+	if index < 0 || index >= count {
+		return ""
+	}
+
+	return s[offsets[index]:]
+}
+
+func (s Str) SliceGraphemesTo(index int) Str {
+	offsets := graphemeOffsets(string(s))
+	count := len(offsets) - 1
+	if index < 0 {
+		index = count + index
+	}
+	if index < 0 || index > count {
+		return ""
+	}
+
+	return s[:offsets[index]]
+}
@@ -0,0 +1,200 @@
+package str
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	inputs, expected := readBreakTest(t, "testdata/GraphemeBreakTest.txt")
+	for i := range inputs {
+		if got := Str(inputs[i]).Graphemes().Strings(); !slices.Equal(got, expected[i]) {
+			t.Errorf("Str(%+q).Graphemes() = %+q, want %+q", inputs[i], got, expected[i])
+		}
+		if got := Str(inputs[i]).GraphemeCount(); got != len(expected[i]) {
+			t.Errorf("Str(%+q).GraphemeCount() = %d, want %d", inputs[i], got, len(expected[i]))
+		}
+	}
+}
+
+func TestGraphemeAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		index    int
+		expected string
+	}{
+		{
+			name:     "ascii",
+			input:    "hello",
+			index:    1,
+			expected: "e",
+		},
+		{
+			name:     "combining accent",
+			input:    "café!",
+			index:    3,
+			expected: "é",
+		},
+		{
+			name:     "zwj family",
+			input:    "a👨‍👩‍👧b",
+			index:    1,
+			expected: "👨‍👩‍👧",
+		},
+		{
+			name:     "flags",
+			input:    "🇩🇪🇫🇷",
+			index:    1,
+			expected: "🇫🇷",
+		},
+		{
+			name:     "out of range",
+			input:    "abc",
+			index:    3,
+			expected: "",
+		},
+		{
+			name:     "negative index",
+			input:    "abc",
+			index:    -1,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.GraphemeAt(tt.index); got != Str(tt.expected) {
+				t.Errorf("Str.GraphemeAt() = %+q, want %+q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSliceGraphemes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		start    int
+		end      int
+		expected string
+	}{
+		{
+			name:     "keeps emoji sequence whole",
+			input:    "hi 👩🏽‍🚀 there",
+			start:    0,
+			end:      4,
+			expected: "hi 👩🏽‍🚀",
+		},
+		{
+			name:     "negative indexes",
+			input:    "néé",
+			start:    -2,
+			end:      -1,
+			expected: "é",
+		},
+		{
+			name:     "flags",
+			input:    "🇯🇵🇰🇷🇨🇳",
+			start:    1,
+			end:      3,
+			expected: "🇰🇷🇨🇳",
+		},
+		{
+			name:     "start after end",
+			input:    "hello",
+			start:    3,
+			end:      2,
+			expected: "",
+		},
+		{
+			name:     "empty",
+			input:    "",
+			start:    0,
+			end:      1,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.SliceGraphemes(tt.start, tt.end); got != Str(tt.expected) {
+				t.Errorf("Str.SliceGraphemes() = %+q, want %+q", got, tt.expected)
+			}
+		})
+	}
+
+	if got := Str("a👍🏿b").SliceGraphemesFrom(1); got != "👍🏿b" {
+		t.Errorf("Str.SliceGraphemesFrom() = %+q", got)
+	}
+	if got := Str("a👍🏿b").SliceGraphemesTo(-1); got != "a👍🏿" {
+		t.Errorf("Str.SliceGraphemesTo() = %+q", got)
+	}
+}
+
+func TestPadGraphemes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		length   int
+		pad      string
+		start    bool
+		expected string
+	}{
+		{
+			name:     "end with accented name",
+			input:    "Zoë",
+			length:   5,
+			pad:      ".",
+			expected: "Zoë..",
+		},
+		{
+			name:     "start with emoji",
+			input:    "🇺🇸",
+			length:   3,
+			pad:      "-",
+			start:    true,
+			expected: "--🇺🇸",
+		},
+		{
+			name:     "emoji pad is not split",
+			input:    "x",
+			length:   4,
+			pad:      "👨‍👩‍👧a",
+			expected: "x👨‍👩‍👧a👨‍👩‍👧",
+		},
+		{
+			name:     "already long enough",
+			input:    "hello",
+			length:   3,
+			pad:      "*",
+			expected: "hello",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.PadEndGraphemes(tt.length, tt.pad)
+			if tt.start {
+				got = tt.input.PadStartGraphemes(tt.length, tt.pad)
+			}
+			if got != Str(tt.expected) {
+				t.Errorf("Str.Pad*Graphemes() = %+q, want %+q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPopGrapheme(t *testing.T) {
+	first, rest := Str("🏳️‍🌈ok").PopGraphemeStart()
+	if first != "🏳️‍🌈" || rest != "ok" {
+		t.Errorf("Str.PopGraphemeStart() = %+q, %+q", first, rest)
+	}
+	last, rest := Str("okñ").PopGraphemeEnd()
+	if last != "ñ" || rest != "ok" {
+		t.Errorf("Str.PopGraphemeEnd() = %+q, %+q", last, rest)
+	}
+	if last, rest := Str("").PopGraphemeEnd(); last != "" || rest != "" {
+		t.Errorf("Str.PopGraphemeEnd() = %+q, %+q", last, rest)
+	}
+}
@@ -0,0 +1,198 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func DefaultCaser() *Caser {
+	return defaultCaser
+}
+
+func (c *Caser) AddIrregular(singular, plural string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rules.AddIrregular(singular, plural)
+}
+
+func (c *Caser) AddPlural(suffix, replacement string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rules.AddPlural(suffix, replacement)
+}
+
+func (c *Caser) AddSingular(suffix, replacement string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rules.AddSingular(suffix, replacement)
+}
+
+func (c *Caser) AddUncountable(words ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, w := range words {
+		c.rules.AddUncountable(w)
+	}
+}
+
+// Classify returns the type name for a table name: "blog_posts" -> "BlogPost".
+func (c *Caser) Classify(s Str) Str {
+	return c.Pascal(c.Singularize(s))
+}
+
+// ForeignKey returns the foreign key column for a type name: "Person" -> "person_id".
+func (c *Caser) ForeignKey(s Str) Str {
+	return c.Snake(c.Singularize(s)) + "_id"
+}
+
+// Humanize returns a sentence-cased phrase for an identifier, dropping a
+// trailing "id" word: "author_id" -> "Author", "HTMLPage" -> "HTML page".
+func (c *Caser) Humanize(s Str) Str {
+	words := c.humanWords(s)
+	if len(words) > 0 {
+		first, remaining := Str(words[0]).PopStart()
+		words[0] = string(Str(first).ToUpperIn(c.locale) + remaining)
+	}
+	return Str(strings.Join(words, " "))
+}
+
+// Ordinalize appends the English ordinal suffix to a number: "22" -> "22nd".
+func (c *Caser) Ordinalize(s Str) Str {
+	return Str(c.rules.Ordinalize(string(s)))
+}
+
+func (c *Caser) Pluralize(s Str) Str {
+	return c.inflectLast(s, true, c.rules.Pluralize)
+}
+
+func (c *Caser) Singularize(s Str) Str {
+	return c.inflectLast(s, false, func(w string) string {
+		// The ruleset strips a trailing s from words that are already
+		// singular, such as "status"; leave words alone when a specific
+		// plural rule maps back to them.
+		if plural := c.rules.Pluralize(w); plural != w && plural != w+"s" && c.rules.Singularize(plural) == w {
+			return w
+		}
+		return c.rules.Singularize(w)
+	})
+}
+
+// Tableize returns the table name for a type name: "SuperPerson" -> "super_people".
+func (c *Caser) Tableize(s Str) Str {
+	return c.Pluralize(c.Snake(s))
+}
+
+func (c *Caser) Titleize(s Str) Str {
+	words := c.humanWords(s)
+	for i, w := range words {
+		first, remaining := Str(w).PopStart()
+		words[i] = string(Str(first).ToUpperIn(c.locale) + remaining)
+	}
+	return Str(strings.Join(words, " "))
+}
+
+// humanWords returns the lower-case words of s with acronyms upper-cased and
+// a trailing "id" removed.
+func (c *Caser) humanWords(s Str) []string {
+	words := c.words(string(s))
+	if n := len(words); n > 1 && strings.EqualFold(words[n-1], "id") {
+		words = words[:n-1]
+	}
+	for i, w := range words {
+		if c.IsAcronym(w) {
+			words[i] = strings.ToUpper(w)
+		} else if c.isPluralAcronym(w) {
+			words[i] = w
+		} else {
+			words[i] = string(Str(w).ToLowerIn(c.locale))
+		}
+	}
+	return words
+}
+
+// inflectLast applies inflect to the lower-cased last word of s and restores
+// the word's original case. Acronyms only gain or lose a lower-case s.
+func (c *Caser) inflectLast(s Str, plural bool, inflect func(string) string) Str {
+	words := c.words(string(s))
+	if len(words) == 0 {
+		return s
+	}
+	last := words[len(words)-1]
+	i := strings.LastIndex(string(s), last)
+
+	var word string
+	if c.isPluralAcronym(last) || isAllUpper(last) && c.IsAcronym(last) {
+		word = strings.TrimSuffix(last, "s")
+		if plural {
+			word += "s"
+		}
+	} else {
+		c.mu.RLock()
+		word = matchCase(inflect(strings.ToLower(last)), last)
+		c.mu.RUnlock()
+	}
+	return s[:i] + Str(word) + s[i+len(last):]
+}
+
+// isPluralAcronym reports whether w is an acronym followed by a lower-case s,
+// as in "URLs".
+func (c *Caser) isPluralAcronym(w string) bool {
+	base, ok := strings.CutSuffix(w, "s")
+	return ok && isAllUpper(base) && c.IsAcronym(base)
+}
+
+func matchCase(word, pattern string) string {
+	first, _ := utf8.DecodeRuneInString(pattern)
+	switch {
+	case isAllUpper(pattern) && utf8.RuneCountInString(pattern) > 1:
+		return strings.ToUpper(word)
+	case unicode.IsUpper(first):
+		r, size := utf8.DecodeRuneInString(word)
+		return string(unicode.ToUpper(r)) + word[size:]
+	}
+	return word
+}
+
+func isAllUpper(s string) bool {
+	upper := false
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return false
+		}
+		upper = upper || unicode.IsUpper(r)
+	}
+	return upper
+}
+
+func (s Str) Classify() Str {
+	return defaultCaser.Classify(s)
+}
+
+func (s Str) ForeignKey() Str {
+	return defaultCaser.ForeignKey(s)
+}
+
+func (s Str) Humanize() Str {
+	return defaultCaser.Humanize(s)
+}
+
+func (s Str) Ordinalize() Str {
+	return defaultCaser.Ordinalize(s)
+}
+
+func (s Str) Pluralize() Str {
+	return defaultCaser.Pluralize(s)
+}
+
+func (s Str) Singularize() Str {
+	return defaultCaser.Singularize(s)
+}
+
+func (s Str) Tableize() Str {
+	return defaultCaser.Tableize(s)
+}
+
+func (s Str) Titleize() Str {
+	return defaultCaser.Titleize(s)
+}
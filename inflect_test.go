@@ -0,0 +1,72 @@
+package str
+
+import "testing"
+
+func TestInflect(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		inflect  func(Str) Str
+		expected string
+	}{
+		{name: "pluralize", input: "post", inflect: Str.Pluralize, expected: "posts"},
+		{name: "pluralize irregular keeps case", input: "Person", inflect: Str.Pluralize, expected: "People"},
+		{name: "pluralize last word", input: "blog_post", inflect: Str.Pluralize, expected: "blog_posts"},
+		{name: "pluralize camel case", input: "SuperPerson", inflect: Str.Pluralize, expected: "SuperPeople"},
+		{name: "pluralize uncountable", input: "sheep", inflect: Str.Pluralize, expected: "sheep"},
+		{name: "pluralize acronym", input: "user URL", inflect: Str.Pluralize, expected: "user URLs"},
+		{name: "singularize", input: "categories", inflect: Str.Singularize, expected: "category"},
+		{name: "singularize already singular", input: "Status", inflect: Str.Singularize, expected: "Status"},
+		{name: "singularize upper", input: "PEOPLE", inflect: Str.Singularize, expected: "PERSON"},
+		{name: "singularize acronym", input: "userIDs", inflect: Str.Singularize, expected: "userID"},
+		{name: "humanize", input: "employee_salary", inflect: Str.Humanize, expected: "Employee salary"},
+		{name: "humanize drops id", input: "author_id", inflect: Str.Humanize, expected: "Author"},
+		{name: "humanize keeps acronyms", input: "HTMLPage", inflect: Str.Humanize, expected: "HTML page"},
+		{name: "titleize", input: "the_lord_of_the_rings", inflect: Str.Titleize, expected: "The Lord Of The Rings"},
+		{name: "tableize", input: "SuperPerson", inflect: Str.Tableize, expected: "super_people"},
+		{name: "classify", input: "blog_posts", inflect: Str.Classify, expected: "BlogPost"},
+		{name: "foreign key", input: "Person", inflect: Str.ForeignKey, expected: "person_id"},
+		{name: "ordinalize", input: "22", inflect: Str.Ordinalize, expected: "22nd"},
+		{name: "ordinalize teen", input: "113", inflect: Str.Ordinalize, expected: "113th"},
+		{name: "ordinalize non number", input: "abc", inflect: Str.Ordinalize, expected: "abc"},
+		{name: "empty", input: "", inflect: Str.Pluralize, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.inflect(tt.input); got != Str(tt.expected) {
+				t.Errorf("inflect(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCaserVocabulary(t *testing.T) {
+	c := NewCaser()
+	c.AddIrregular("cactus", "cacti")
+	c.AddUncountable("metadata", "firmware")
+	c.AddPlural("foot", "feet")
+	c.AddSingular("feet", "foot")
+
+	tests := []struct {
+		input    Str
+		inflect  func(*Caser, Str) Str
+		expected string
+	}{
+		{input: "cactus", inflect: (*Caser).Pluralize, expected: "cacti"},
+		{input: "Cacti", inflect: (*Caser).Singularize, expected: "Cactus"},
+		{input: "user_metadata", inflect: (*Caser).Pluralize, expected: "user_metadata"},
+		{input: "firmware", inflect: (*Caser).Tableize, expected: "firmware"},
+		{input: "square_foot", inflect: (*Caser).Pluralize, expected: "square_feet"},
+		{input: "SquareFeet", inflect: (*Caser).Singularize, expected: "SquareFoot"},
+	}
+	for _, tt := range tests {
+		if got := tt.inflect(c, tt.input); got != Str(tt.expected) {
+			t.Errorf("inflect(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+
+	if got := Str("cactus").Pluralize(); got == "cacti" {
+		t.Errorf("default caser was modified: %q", got)
+	}
+}
@@ -0,0 +1,129 @@
+package str
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+func (s Array) Join(sep string) Str {
+	return Str(strings.Join(s.Strings(), sep))
+}
+
+// JoinLast is like Join but puts lastSep before the last element, as in
+// "a, b and c".
+func (s Array) JoinLast(sep, lastSep string) Str {
+	if len(s) < 2 {
+		return s.Join(sep)
+	}
+	return s[:len(s)-1].Join(sep) + Str(lastSep) + s[len(s)-1]
+}
+
+type listOptions struct {
+	or     bool
+	oxford bool
+}
+
+type ListOption func(*listOptions)
+
+// WithListOr joins the last element with "or" instead of "and".
+func WithListOr() ListOption {
+	return func(o *listOptions) {
+		o.or = true
+	}
+}
+
+// WithOxfordComma sets whether English lists of three or more elements get a
+// comma before the conjunction. It is on by default and has no effect in
+// other languages.
+func WithOxfordComma(enabled bool) ListOption {
+	return func(o *listOptions) {
+		o.oxford = enabled
+	}
+}
+
+// listConjunctions holds "and" and "or" by language.
+var listConjunctions = map[string][2]string{
+	"en": {"and", "or"},
+	"de": {"und", "oder"},
+	"es": {"y", "o"},
+	"fr": {"et", "ou"},
+	"it": {"e", "o"},
+	"nl": {"en", "of"},
+	"pt": {"e", "ou"},
+}
+
+// JoinList joins s as a list in running text of the language of tag, such as
+// "a, b, and c" in English or "a, b et c" in French. Unsupported languages
+// use English.
+func (s Array) JoinList(tag language.Tag, opts ...ListOption) Str {
+	o := listOptions{oxford: true}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	base, _ := tag.Base()
+	lang := base.String()
+	words, ok := listConjunctions[lang]
+	if !ok {
+		lang, words = "en", listConjunctions["en"]
+	}
+	word := words[0]
+	if o.or {
+		word = words[1]
+	}
+	if len(s) < 2 {
+		return s.Join(", ")
+	}
+
+	if lang == "es" {
+		word = spanishConjunction(word, s[len(s)-1])
+	}
+	last := " " + word + " "
+	if lang == "en" && o.oxford && len(s) > 2 {
+		last = "," + last
+	}
+	return s.JoinLast(", ", last)
+}
+
+// spanishConjunction turns "y" into "e" before an /i/ sound and "o" into "u"
+// before an /o/ sound, so "agua e hilo" but "agua y hielo".
+func spanishConjunction(word string, next Str) string {
+	r := []rune(strings.TrimPrefix(strings.ToLower(string(next)), "h"))
+	if len(r) == 0 {
+		return word
+	}
+	switch {
+	case word == "y" && (r[0] == 'i' || r[0] == 'í') && (len(r) == 1 || !strings.ContainsRune("aeoáéó", r[1])):
+		return "e"
+	case word == "o" && (r[0] == 'o' || r[0] == 'ó'):
+		return "u"
+	}
+	return word
+}
+
+// Quote returns the elements of s as double-quoted Go string literals.
+func (s Array) Quote() Array {
+	return s.Map(func(v Str) Str {
+		return Str(strconv.Quote(string(v)))
+	})
+}
+
+// Unquote interprets every element of s as a quoted Go string literal. It
+// returns an error naming the first element that is not one.
+func (s Array) Unquote() (Array, error) {
+	if s == nil {
+		return nil, nil
+	}
+	out := make(Array, len(s))
+	for i, v := range s {
+		u, err := strconv.Unquote(string(v))
+		if err != nil {
+			return nil, fmt.Errorf("str: Unquote element %d %s: %w", i, strconv.Quote(string(v)), err)
+		}
+		out[i] = Str(u)
+	}
+	return out, nil
+}
@@ -0,0 +1,84 @@
+package str
+
+import (
+	"slices"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestArrayJoin(t *testing.T) {
+	tests := []struct {
+		input Array
+		join  Str
+		last  Str
+	}{
+		{nil, "", ""},
+		{Array{"a"}, "a", "a"},
+		{Array{"a", "b"}, "a, b", "a and b"},
+		{Array{"a", "b", "c"}, "a, b, c", "a, b and c"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.Join(", "); got != tt.join {
+			t.Errorf("Array(%q).Join() = %q, want %q", tt.input, got, tt.join)
+		}
+		if got := tt.input.JoinLast(", ", " and "); got != tt.last {
+			t.Errorf("Array(%q).JoinLast() = %q, want %q", tt.input, got, tt.last)
+		}
+	}
+}
+
+func TestJoinList(t *testing.T) {
+	three := Array{"red", "green", "blue"}
+	tests := []struct {
+		name     string
+		input    Array
+		tag      language.Tag
+		opts     []ListOption
+		expected Str
+	}{
+		{"english", three, language.English, nil, "red, green, and blue"},
+		{"english without oxford comma", three, language.BritishEnglish, []ListOption{WithOxfordComma(false)}, "red, green and blue"},
+		{"english pair", Array{"a", "b"}, language.English, nil, "a and b"},
+		{"english or", three, language.English, []ListOption{WithListOr()}, "red, green, or blue"},
+		{"german", three, language.German, nil, "red, green und blue"},
+		{"german or", three, language.German, []ListOption{WithListOr()}, "red, green oder blue"},
+		{"french", three, language.French, nil, "red, green et blue"},
+		{"spanish", three, language.Spanish, nil, "red, green y blue"},
+		{"spanish e", Array{"agua", "hilo"}, language.Spanish, nil, "agua e hilo"},
+		{"spanish diphthong", Array{"agua", "hielo"}, language.Spanish, nil, "agua y hielo"},
+		{"spanish u", Array{"siete", "ocho"}, language.Spanish, []ListOption{WithListOr()}, "siete u ocho"},
+		{"regional tag", three, language.MustParse("fr-CA"), nil, "red, green et blue"},
+		{"fallback", three, language.Japanese, nil, "red, green, and blue"},
+		{"single", Array{"only"}, language.English, nil, "only"},
+		{"empty", nil, language.English, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.JoinList(tt.tag, tt.opts...); got != tt.expected {
+				t.Errorf("Array.JoinList() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	a := Array{"plain", `with "quotes"`, "tab\there", "ü"}
+	quoted := a.Quote()
+	if !slices.Equal(quoted, Array{`"plain"`, `"with \"quotes\""`, `"tab\there"`, `"ü"`}) {
+		t.Errorf("Array.Quote() = %q", quoted)
+	}
+
+	got, err := quoted.Unquote()
+	if err != nil || !slices.Equal(got, a) {
+		t.Errorf("Array.Unquote() = %q, %v", got, err)
+	}
+	if got, err := (Array{`"ok"`, `bad`}).Unquote(); err == nil || got != nil {
+		t.Errorf("Array.Unquote() = %q, %v, want error", got, err)
+	}
+	if got, err := (Array{"`raw`", `'x'`}).Unquote(); err != nil || !slices.Equal(got, Array{"raw", "x"}) {
+		t.Errorf("Array.Unquote() = %q, %v", got, err)
+	}
+}
@@ -0,0 +1,180 @@
+package str
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// NewlineStyle is the line terminator written by NormalizeNewlines.
+type NewlineStyle string
+
+const (
+	NewlineLF   NewlineStyle = "\n"
+	NewlineCRLF NewlineStyle = "\r\n"
+	NewlineCR   NewlineStyle = "\r"
+)
+
+// cutLine splits s at its first line terminator, one of "\n", "\r\n", "\r",
+// U+2028 and U+2029, and returns the line, the terminator and the rest.
+func cutLine(s string) (line, term, rest string) {
+	i := strings.IndexAny(s, "\n\r\u2028\u2029")
+	if i < 0 {
+		return s, "", ""
+	}
+
+	n := 1
+	switch {
+	case strings.HasPrefix(s[i:], "\r\n"):
+		n = 2
+	case s[i] != '\n' && s[i] != '\r':
+		n = 3
+	}
+	return s[:i], s[i : i+n], s[i+n:]
+}
+
+// Lines splits s into lines, recognising "\n", "\r\n", "\r", U+2028 and
+// U+2029 as terminators. A final terminator does not start another line.
+func (s Str) Lines() Array {
+	var out Array
+	for rest := string(s); rest != ""; {
+		var line string
+		line, _, rest = cutLine(rest)
+		out = append(out, Str(line))
+	}
+	return out
+}
+
+// LineAt returns the line at index, counted from 0, or "" if s has no such
+// line.
+func (s Str) LineAt(index int) Str {
+	if index < 0 {
+		return ""
+	}
+	for rest := string(s); rest != ""; index-- {
+		var line string
+		line, _, rest = cutLine(rest)
+		if index == 0 {
+			return Str(line)
+		}
+	}
+	return ""
+}
+
+// mapLines rebuilds s with fn applied to every line, keeping the terminators.
+func (s Str) mapLines(fn func(string) string) Str {
+	var b strings.Builder
+	for rest := string(s); rest != ""; {
+		var line, term string
+		line, term, rest = cutLine(rest)
+		b.WriteString(fn(line))
+		b.WriteString(term)
+	}
+	return Str(b.String())
+}
+
+// Indent prefixes every line of s that is not blank with prefix.
+func (s Str) Indent(prefix string) Str {
+	return s.mapLines(func(line string) string {
+		if strings.TrimSpace(line) == "" {
+			return line
+		}
+		return prefix + line
+	})
+}
+
+// Dedent removes the leading spaces and tabs common to every line of s that
+// is not blank, like Python's textwrap.dedent. Blank lines are emptied.
+func (s Str) Dedent() Str {
+	margin, found := "", false
+	for rest := string(s); rest != ""; {
+		var line string
+		line, _, rest = cutLine(rest)
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		switch {
+		case !found:
+			margin, found = indent, true
+		case strings.HasPrefix(indent, margin):
+		default:
+			n := 0
+			for n < len(margin) && n < len(indent) && margin[n] == indent[n] {
+				n++
+			}
+			margin = margin[:n]
+		}
+	}
+
+	return s.mapLines(func(line string) string {
+		if strings.TrimSpace(line) == "" {
+			return ""
+		}
+		return line[len(margin):]
+	})
+}
+
+// TrimLines removes the leading and trailing white space of every line of s.
+func (s Str) TrimLines() Str {
+	return s.mapLines(strings.TrimSpace)
+}
+
+// NormalizeNewlines replaces every line terminator in s with style.
+func (s Str) NormalizeNewlines(style NewlineStyle) Str {
+	var b strings.Builder
+	for rest := string(s); rest != ""; {
+		var line, term string
+		line, term, rest = cutLine(rest)
+		b.WriteString(line)
+		if term != "" {
+			b.WriteString(string(style))
+		}
+	}
+	return Str(b.String())
+}
+
+// Position returns the 1-based line and column of the byte offset in s, with
+// columns counted in runes, for error messages. Offsets out of range are
+// clamped.
+func (s Str) Position(offset int) (line, column int) {
+	offset = min(max(offset, 0), len(s))
+	line, start := 1, 0
+	for rest := string(s); ; line++ {
+		text, term, next := cutLine(rest)
+		end := start + len(text) + len(term)
+		if term == "" || offset < end {
+			break
+		}
+		start, rest = end, next
+	}
+	return line, utf8.RuneCountInString(string(s[start:offset])) + 1
+}
+
+// Offset returns the byte offset of the 1-based line and column, counted in
+// runes, or -1 if s has no such position. The column just past the end of a
+// line is valid.
+func (s Str) Offset(line, column int) int {
+	if line < 1 || column < 1 {
+		return -1
+	}
+	rest := string(s)
+	for ; line > 1; line-- {
+		_, term, next := cutLine(rest)
+		if term == "" {
+			return -1
+		}
+		rest = next
+	}
+
+	text, _, _ := cutLine(rest)
+	offset := len(s) - len(rest)
+	for column--; column > 0; column-- {
+		if text == "" {
+			return -1
+		}
+		_, size := utf8.DecodeRuneInString(text)
+		text = text[size:]
+		offset += size
+	}
+	return offset
+}
@@ -0,0 +1,132 @@
+package str
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		input    Str
+		expected Array
+	}{
+		{"", nil},
+		{"one", Array{"one"}},
+		{"one\n", Array{"one"}},
+		{"one\ntwo\r\nthree\rfour\u2028five\u2029six", Array{"one", "two", "three", "four", "five", "six"}},
+		{"\n\n", Array{"", ""}},
+		{"a\r\n\r\nb", Array{"a", "", "b"}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.Lines(); !slices.Equal(got, tt.expected) {
+			t.Errorf("Str(%q).Lines() = %q, want %q", tt.input, got, tt.expected)
+		}
+		for i, line := range tt.expected {
+			if got := tt.input.LineAt(i); got != line {
+				t.Errorf("Str(%q).LineAt(%d) = %q, want %q", tt.input, i, got, line)
+			}
+		}
+		if got := tt.input.LineAt(len(tt.expected)); got != "" {
+			t.Errorf("Str(%q).LineAt(%d) = %q, want empty", tt.input, len(tt.expected), got)
+		}
+	}
+}
+
+func TestIndent(t *testing.T) {
+	tests := []struct {
+		input    Str
+		expected Str
+	}{
+		{"a\nb\n", "> a\n> b\n"},
+		{"a\r\n\r\n  \r\nb", "> a\r\n\r\n  \r\n> b"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.Indent("> "); got != tt.expected {
+			t.Errorf("Str(%q).Indent() = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestDedent(t *testing.T) {
+	tests := []struct {
+		input    Str
+		expected Str
+	}{
+		{"    a\n      b\n    c\n", "a\n  b\nc\n"},
+		{"\n\tfunc f() {\n\t\treturn\n\t}\n", "\nfunc f() {\n\treturn\n}\n"},
+		{"  a\n   \n  b", "a\n\nb"},
+		{"  a\n\tb", "  a\n\tb"},
+		{"  \ta\n  b", "\ta\nb"},
+		{"no indent\n  x", "no indent\n  x"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.Dedent(); got != tt.expected {
+			t.Errorf("Str(%q).Dedent() = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestTrimLines(t *testing.T) {
+	if got := Str("  a  \r\n\tb\t\n").TrimLines(); got != "a\r\nb\n" {
+		t.Errorf("Str.TrimLines() = %q", got)
+	}
+}
+
+func TestNormalizeNewlines(t *testing.T) {
+	input := Str("a\nb\r\nc\rd\u2028e\n")
+	tests := []struct {
+		style    NewlineStyle
+		expected Str
+	}{
+		{NewlineLF, "a\nb\nc\nd\ne\n"},
+		{NewlineCRLF, "a\r\nb\r\nc\r\nd\r\ne\r\n"},
+		{NewlineCR, "a\rb\rc\rd\re\r"},
+	}
+
+	for _, tt := range tests {
+		if got := input.NormalizeNewlines(tt.style); got != tt.expected {
+			t.Errorf("Str.NormalizeNewlines(%q) = %q, want %q", tt.style, got, tt.expected)
+		}
+	}
+}
+
+func TestPosition(t *testing.T) {
+	s := Str("ab\r\nçd\n\nx")
+	tests := []struct {
+		offset       int
+		line, column int
+	}{
+		{0, 1, 1},
+		{2, 1, 3},
+		{4, 2, 1},
+		{6, 2, 2},
+		{7, 2, 3},
+		{8, 3, 1},
+		{9, 4, 1},
+		{10, 4, 2},
+		{-5, 1, 1},
+		{99, 4, 2},
+	}
+
+	for _, tt := range tests {
+		line, column := s.Position(tt.offset)
+		if line != tt.line || column != tt.column {
+			t.Errorf("Str.Position(%d) = %d:%d, want %d:%d", tt.offset, line, column, tt.line, tt.column)
+		}
+		if tt.offset >= 0 && tt.offset <= len(s) {
+			if got := s.Offset(line, column); got != tt.offset {
+				t.Errorf("Str.Offset(%d, %d) = %d, want %d", line, column, got, tt.offset)
+			}
+		}
+	}
+
+	for _, pos := range [][2]int{{0, 1}, {1, 0}, {1, 4}, {5, 1}} {
+		if got := s.Offset(pos[0], pos[1]); got != -1 {
+			t.Errorf("Str.Offset(%d, %d) = %d, want -1", pos[0], pos[1], got)
+		}
+	}
+}
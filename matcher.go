@@ -0,0 +1,111 @@
+package str
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Matcher searches for many needles at once in a single pass over the text.
+// Build one with NewMatcher to reuse it across documents; the Str methods
+// such as ContainsAll build a new one on each call. A Matcher is safe for
+// concurrent use.
+type Matcher struct {
+	m     *acMatcher
+	empty int // index of the first empty needle, or -1
+}
+
+// Occurrence is a needle found by FindAllOf: the byte offsets of the text it
+// matched and the needle's index.
+type Occurrence struct {
+	Start, End int
+	Needle     int
+}
+
+func NewMatcher(needles ...string) *Matcher {
+	return newMatcher(needles, false)
+}
+
+// NewMatcherFold is like NewMatcher but matches under Unicode simple case
+// folding.
+func NewMatcherFold(needles ...string) *Matcher {
+	return newMatcher(needles, true)
+}
+
+func newMatcher(needles []string, fold bool) *Matcher {
+	return &Matcher{
+		m:     newACMatcher(needles, fold),
+		empty: slices.Index(needles, ""),
+	}
+}
+
+// ContainsAll reports whether every needle occurs in s.
+func (m *Matcher) ContainsAll(s Str) bool {
+	missing := make(map[int32]struct{})
+	for _, id := range m.m.ids {
+		if id >= 0 {
+			missing[id] = struct{}{}
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+
+	m.m.each(string(s), func(_, _, p int) bool {
+		delete(missing, int32(p))
+		return len(missing) > 0
+	})
+	return len(missing) == 0
+}
+
+// Index returns the byte offset of the leftmost occurrence of any needle and
+// the index of that needle, preferring the longest needle at that offset. It
+// returns -1, -1 if none occurs.
+func (m *Matcher) Index(s Str) (idx, which int) {
+	start, _, p := m.m.next(string(s), 0)
+	if m.empty >= 0 && (p < 0 || start > 0) {
+		return 0, m.empty
+	}
+	if p < 0 {
+		return -1, -1
+	}
+	return start, p
+}
+
+// FindAll returns every occurrence of every needle, overlapping ones
+// included, ordered by start offset and then by length. Empty needles are not
+// reported. Needles that are listed more than once are reported under their
+// first index.
+func (m *Matcher) FindAll(s Str) []Occurrence {
+	var out []Occurrence
+	m.m.each(string(s), func(start, end, p int) bool {
+		out = append(out, Occurrence{Start: start, End: end, Needle: p})
+		return true
+	})
+	slices.SortFunc(out, func(a, b Occurrence) int {
+		return cmp.Or(cmp.Compare(a.Start, b.Start), cmp.Compare(a.End, b.End))
+	})
+	return out
+}
+
+func (s Str) ContainsAll(needles ...string) bool {
+	return NewMatcher(needles...).ContainsAll(s)
+}
+
+// ContainsWhich returns the needle that occurs first in s and its index in
+// needles, or "", -1 if none does.
+func (s Str) ContainsWhich(needles ...string) (Str, int) {
+	if _, which := s.IndexAnyOf(needles...); which >= 0 {
+		return Str(needles[which]), which
+	}
+	return "", -1
+}
+
+// IndexAnyOf returns the byte offset of the leftmost occurrence in s of any of
+// needles and the index of that needle, or -1, -1. See Matcher.Index.
+func (s Str) IndexAnyOf(needles ...string) (idx, which int) {
+	return NewMatcher(needles...).Index(s)
+}
+
+func (s Str) FindAllOf(needles ...string) []Occurrence {
+	return NewMatcher(needles...).FindAll(s)
+}
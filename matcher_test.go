@@ -0,0 +1,134 @@
+package str
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestContainsAll(t *testing.T) {
+	tests := []struct {
+		input    Str
+		needles  []string
+		expected bool
+	}{
+		{"the quick brown fox", []string{"quick", "fox"}, true},
+		{"the quick brown fox", []string{"quick", "dog"}, false},
+		{"ushers", []string{"she", "he", "hers"}, true},
+		{"abc", []string{"abc", "abc"}, true},
+		{"abc", []string{""}, true},
+		{"abc", nil, true},
+		{"", []string{"a"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.ContainsAll(tt.needles...); got != tt.expected {
+			t.Errorf("Str(%q).ContainsAll(%q) = %v, want %v", tt.input, tt.needles, got, tt.expected)
+		}
+	}
+}
+
+func TestIndexAnyOf(t *testing.T) {
+	tests := []struct {
+		input   Str
+		needles []string
+		idx     int
+		which   int
+	}{
+		{"the quick brown fox", []string{"fox", "quick"}, 4, 1},
+		{"the quick brown fox", []string{"dog"}, -1, -1},
+		{"abcd", []string{"bcd", "b", "bc"}, 1, 0},
+		{"日本語の文章", []string{"文章", "語"}, 6, 1},
+		{"abc", []string{"c", ""}, 0, 1},
+		{"abc", []string{"", "ab"}, 0, 1},
+		{"abc", nil, -1, -1},
+	}
+
+	for _, tt := range tests {
+		idx, which := tt.input.IndexAnyOf(tt.needles...)
+		if idx != tt.idx || which != tt.which {
+			t.Errorf("Str(%q).IndexAnyOf(%q) = %d, %d, want %d, %d", tt.input, tt.needles, idx, which, tt.idx, tt.which)
+		}
+	}
+
+	if s, which := Str("error: disk full").ContainsWhich("warning", "error"); s != "error" || which != 1 {
+		t.Errorf("Str.ContainsWhich() = %q, %d", s, which)
+	}
+	if s, which := Str("all good").ContainsWhich("warning", "error"); s != "" || which != -1 {
+		t.Errorf("Str.ContainsWhich() = %q, %d", s, which)
+	}
+}
+
+func TestFindAllOf(t *testing.T) {
+	got := Str("ushers").FindAllOf("he", "she", "hers", "x")
+	expected := []Occurrence{{1, 4, 1}, {2, 4, 0}, {2, 6, 2}}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Str.FindAllOf() = %v, want %v", got, expected)
+	}
+
+	got = Str("aaa").FindAllOf("a", "aa")
+	expected = []Occurrence{{0, 1, 0}, {0, 2, 1}, {1, 2, 0}, {1, 3, 1}, {2, 3, 0}}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Str.FindAllOf() = %v, want %v", got, expected)
+	}
+
+	if got := Str("abc").FindAllOf("x", ""); got != nil {
+		t.Errorf("Str.FindAllOf() = %v, want nil", got)
+	}
+}
+
+func TestMatcherFold(t *testing.T) {
+	m := NewMatcherFold("ERROR", "ſtop")
+	if idx, which := m.Index("an Error occurred"); idx != 3 || which != 0 {
+		t.Errorf("Matcher.Index() = %d, %d", idx, which)
+	}
+	if !m.ContainsAll("STOP on error") {
+		t.Error("Matcher.ContainsAll() = false, want true")
+	}
+
+	got := m.FindAll("ſtop STOP")
+	expected := []Occurrence{{0, 5, 1}, {6, 10, 1}}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Matcher.FindAll() = %v, want %v", got, expected)
+	}
+}
+
+func TestMatcherFindAllNaive(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	word := func(n int) string {
+		b := make([]byte, 1+rng.Intn(n))
+		for i := range b {
+			b[i] = "ab"[rng.Intn(2)]
+		}
+		return string(b)
+	}
+
+	for range 300 {
+		needles := make([]string, 1+rng.Intn(4))
+		for i := range needles {
+			needles[i] = word(3)
+		}
+		input := word(20)
+
+		var expected []Occurrence
+		for i := range input {
+			for j := range input[i:] {
+				if k := slices.Index(needles, input[i:i+j+1]); k >= 0 {
+					expected = append(expected, Occurrence{i, i + j + 1, k})
+				}
+			}
+		}
+		if got := Str(input).FindAllOf(needles...); !slices.Equal(got, expected) {
+			t.Fatalf("Str(%q).FindAllOf(%q) = %v, want %v", input, needles, got, expected)
+		}
+
+		all := true
+		for _, n := range needles {
+			all = all && strings.Contains(input, n)
+		}
+		if got := Str(input).ContainsAll(needles...); got != all {
+			t.Fatalf("Str(%q).ContainsAll(%q) = %v, want %v", input, needles, got, all)
+		}
+	}
+}
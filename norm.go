@@ -0,0 +1,68 @@
+package str
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+func (s Str) Normalize(form norm.Form) Str {
+	return Str(form.String(string(s)))
+}
+
+func (s Str) IsNormalized(form norm.Form) bool {
+	return form.IsNormalString(string(s))
+}
+
+func (s Str) NFC() Str {
+	return s.Normalize(norm.NFC)
+}
+
+func (s Str) NFD() Str {
+	return s.Normalize(norm.NFD)
+}
+
+func (s Str) NFKC() Str {
+	return s.Normalize(norm.NFKC)
+}
+
+func (s Str) NFKD() Str {
+	return s.Normalize(norm.NFKD)
+}
+
+// EqualNormalized reports whether s and value are equal once both are
+// normalized to form.
+func (s Str) EqualNormalized(value string, form norm.Form) bool {
+	return form.String(string(s)) == form.String(value)
+}
+
+// EqualFold reports whether s and value are equal under full Unicode case
+// folding and canonical equivalence, so "Straße" matches "STRASSE".
+func (s Str) EqualFold(value string) bool {
+	return foldCanonical(string(s)) == foldCanonical(value)
+}
+
+func (s Str) ContainsNormalized(form norm.Form, values ...string) bool {
+	str := form.String(string(s))
+	for i := range values {
+		if strings.Contains(str, form.String(values[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Str) InNormalized(form norm.Form, values ...string) bool {
+	str := form.String(string(s))
+	for i := range values {
+		if str == form.String(values[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func foldCanonical(s string) string {
+	return norm.NFD.String(cases.Fold().String(norm.NFD.String(s)))
+}
@@ -0,0 +1,112 @@
+package str
+
+import (
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		convert  func(Str) Str
+		expected string
+	}{
+		{
+			name:     "nfc composes",
+			input:    "e\u0301",
+			convert:  Str.NFC,
+			expected: "é",
+		},
+		{
+			name:     "nfd decomposes",
+			input:    "é",
+			convert:  Str.NFD,
+			expected: "e\u0301",
+		},
+		{
+			name:     "nfkc folds compatibility characters",
+			input:    "ﬁ①",
+			convert:  Str.NFKC,
+			expected: "fi1",
+		},
+		{
+			name:     "nfkd decomposes compatibility characters",
+			input:    "ǅ",
+			convert:  Str.NFKD,
+			expected: "Dz\u030c",
+		},
+		{
+			name:     "ascii unchanged",
+			input:    "hello",
+			convert:  Str.NFC,
+			expected: "hello",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.convert(tt.input); got != Str(tt.expected) {
+				t.Errorf("normalize(%+q) = %+q, want %+q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEqualNormalized(t *testing.T) {
+	if !Str("café").EqualNormalized("cafe\u0301", norm.NFC) {
+		t.Error("precomposed and decomposed é should be equal under NFC")
+	}
+	if Str("ﬁ").EqualNormalized("fi", norm.NFC) {
+		t.Error("ligature should differ from fi under NFC")
+	}
+	if !Str("ﬁ").EqualNormalized("fi", norm.NFKC) {
+		t.Error("ligature should equal fi under NFKC")
+	}
+	if !Str("é").IsNormalized(norm.NFC) || Str("e\u0301").IsNormalized(norm.NFC) {
+		t.Error("Str.IsNormalized() reported the wrong form")
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		value    string
+		expected bool
+	}{
+		{name: "ascii", input: "Hello", value: "hELLO", expected: true},
+		{name: "sharp s", input: "Straße", value: "STRASSE", expected: true},
+		{name: "final sigma", input: "ΟΔΟΣ", value: "οδος", expected: true},
+		{name: "decomposed accents", input: "CAFE\u0301", value: "café", expected: true},
+		{name: "different", input: "hello", value: "help", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.EqualFold(tt.value); got != tt.expected {
+				t.Errorf("Str.EqualFold(%q) = %v, want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestContainsNormalized(t *testing.T) {
+	s := Str("Cre\u0300me bru\u0302le\u0301e")
+	if s.Contains("Crème") {
+		t.Error("Str.Contains() should not match across normalization forms")
+	}
+	if !s.ContainsNormalized(norm.NFC, "xyz", "Crème") {
+		t.Error("Str.ContainsNormalized() should match under NFC")
+	}
+	if s.ContainsNormalized(norm.NFC, "xyz") {
+		t.Error("Str.ContainsNormalized() matched a missing value")
+	}
+	if !Str("été").InNormalized(norm.NFD, "ete", "e\u0301te\u0301") {
+		t.Error("Str.InNormalized() should match under NFD")
+	}
+	if Str("été").In("e\u0301te\u0301") {
+		t.Error("Str.In() should not match across normalization forms")
+	}
+}
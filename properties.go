@@ -0,0 +1,32 @@
+package str
+
+//go:generate go run gen_tables.go
+
+type propertyRange struct {
+	lo, hi rune
+	prop   uint8
+}
+
+func lookupProperty(table []propertyRange, r rune) uint8 {
+	lo, hi := 0, len(table)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch {
+		case r < table[m].lo:
+			hi = m
+		case r > table[m].hi:
+			lo = m + 1
+		default:
+			return table[m].prop
+		}
+	}
+	return 0
+}
+
+func isExtendedPictographic(r rune) bool {
+	return lookupProperty(extPictRanges, r) != xpOther
+}
+
+func isEmojiPresentation(r rune) bool {
+	return lookupProperty(emojiPresentationRanges, r) != epOther
+}
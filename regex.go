@@ -0,0 +1,197 @@
+package str
+
+import (
+	"container/list"
+	"regexp"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+const defaultRegexpCacheSize = 256
+
+var (
+	regexpCache       = newRegexpLRU(defaultRegexpCacheSize)
+	panicOnBadPattern atomic.Bool
+)
+
+// Compile returns the compiled form of pattern, reusing the work of a
+// previous compilation from the package-wide cache when possible. Each call
+// returns a distinct *regexp.Regexp, so calling Longest on it affects no other
+// user of the pattern.
+func Compile(pattern string) (*regexp.Regexp, error) {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return nil, err
+	}
+	cp := *re
+	return &cp, nil
+}
+
+// MustCompile is like Compile but panics if pattern cannot be parsed.
+func MustCompile(pattern string) *regexp.Regexp {
+	re, err := Compile(pattern)
+	if err != nil {
+		panic(`str: Compile(` + strconv.Quote(pattern) + `): ` + err.Error())
+	}
+	return re
+}
+
+// SetRegexpCacheSize bounds the number of compiled patterns kept by the
+// cache. A size of 0 disables caching.
+func SetRegexpCacheSize(size int) {
+	regexpCache.resize(size)
+}
+
+// SetPanicOnBadPattern makes the pattern-taking methods such as Find and
+// Match panic on invalid patterns instead of treating them as non-matching.
+// It is intended for tests.
+func SetPanicOnBadPattern(enabled bool) {
+	panicOnBadPattern.Store(enabled)
+}
+
+// compilePattern compiles pattern for the methods that have no error result.
+func compilePattern(pattern string) *regexp.Regexp {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		if panicOnBadPattern.Load() {
+			panic(`str: Compile(` + strconv.Quote(pattern) + `): ` + err.Error())
+		}
+		return nil
+	}
+	return re
+}
+
+type regexpEntry struct {
+	pattern string
+	re      *regexp.Regexp
+	err     error
+}
+
+// regexpLRU is a bounded, concurrency-safe least-recently-used cache of
+// compiled patterns. Compilation errors are cached too, so that a bad pattern
+// used in a loop is not parsed again on every call. The cached regexps are
+// shared and must not be reconfigured.
+type regexpLRU struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newRegexpLRU(size int) *regexpLRU {
+	return &regexpLRU{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *regexpLRU) get(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(e)
+		c.mu.Unlock()
+		entry := e.Value.(*regexpEntry)
+		return entry.re, entry.err
+	}
+	c.mu.Unlock()
+
+	re, err := regexp.Compile(pattern)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(e)
+		entry := e.Value.(*regexpEntry)
+		return entry.re, entry.err
+	}
+	if c.size > 0 {
+		c.entries[pattern] = c.order.PushFront(&regexpEntry{pattern: pattern, re: re, err: err})
+		c.evict()
+	}
+	return re, err
+}
+
+func (c *regexpLRU) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *regexpLRU) resize(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.size = max(size, 0)
+	c.evict()
+}
+
+func (c *regexpLRU) evict() {
+	for c.order.Len() > c.size {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.entries, e.Value.(*regexpEntry).pattern)
+	}
+}
+
+func (s Str) FindE(pattern string) (Str, error) {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return "", err
+	}
+	return s.FindRegex(re), nil
+}
+
+func (s Str) FindAllE(pattern string) (Array, error) {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return s.FindAllRegex(re), nil
+}
+
+func (s Str) FindAllIndexE(pattern string) ([][]int, error) {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return s.FindAllIndexRegex(re), nil
+}
+
+func (s Str) FindIndexE(pattern string) ([]int, error) {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return s.FindIndexRegex(re), nil
+}
+
+// MatchE reports whether s matches any of patterns. Every pattern is
+// compiled first so that a typo is reported even when another one matches.
+func (s Str) MatchE(patterns ...string) (bool, error) {
+	regexes := make([]*regexp.Regexp, len(patterns))
+	for i := range patterns {
+		re, err := regexpCache.get(patterns[i])
+		if err != nil {
+			return false, err
+		}
+		regexes[i] = re
+	}
+	return s.MatchRegex(regexes...), nil
+}
+
+func (s Str) ReplacePatternE(pattern, new string) (Str, error) {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return s, err
+	}
+	return s.ReplaceRegex(re, new), nil
+}
+
+func (s Str) SplitPatternE(pattern string) (Array, error) {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return Array{s}, err
+	}
+	return s.SplitRegex(re), nil
+}
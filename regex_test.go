@@ -0,0 +1,137 @@
+package str
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRegexpLRU(t *testing.T) {
+	c := newRegexpLRU(2)
+	a, _ := c.get("a+")
+	c.get("b+")
+	if again, _ := c.get("a+"); again != a {
+		t.Error("cached pattern was compiled again")
+	}
+	c.get("c+")
+	if c.len() != 2 {
+		t.Fatalf("cache len = %d, want 2", c.len())
+	}
+	if _, ok := c.entries["b+"]; ok {
+		t.Error("least recently used pattern was not evicted")
+	}
+	if _, err := c.get("("); err == nil {
+		t.Error("invalid pattern compiled without error")
+	}
+	if e, ok := c.entries["("]; !ok || e.Value.(*regexpEntry).err == nil {
+		t.Error("invalid pattern error was not cached")
+	}
+	if _, err := c.get("("); err == nil {
+		t.Error("cached invalid pattern returned no error")
+	}
+
+	c.resize(0)
+	c.get("d+")
+	if c.len() != 0 {
+		t.Errorf("cache len = %d, want 0 when disabled", c.len())
+	}
+}
+
+func TestRegexpLRUConcurrent(t *testing.T) {
+	c := newRegexpLRU(8)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := c.get(fmt.Sprintf("x{%d}", j%16)); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if c.len() > 8 {
+		t.Errorf("cache len = %d, want at most 8", c.len())
+	}
+}
+
+func TestPatternE(t *testing.T) {
+	s := Str("a1b22c333")
+	if got, err := s.FindE(`\d+`); err != nil || got != "1" {
+		t.Errorf("Str.FindE() = %q, %v", got, err)
+	}
+	if got, err := s.FindAllE(`\d+`); err != nil || len(got) != 3 {
+		t.Errorf("Str.FindAllE() = %q, %v", got, err)
+	}
+	if got, err := s.FindIndexE(`b`); err != nil || got[0] != 2 {
+		t.Errorf("Str.FindIndexE() = %v, %v", got, err)
+	}
+	if got, err := s.FindAllIndexE(`c`); err != nil || len(got) != 1 {
+		t.Errorf("Str.FindAllIndexE() = %v, %v", got, err)
+	}
+	if got, err := s.MatchE(`^x`, `c\d+$`); err != nil || !got {
+		t.Errorf("Str.MatchE() = %v, %v", got, err)
+	}
+	if got, err := s.ReplacePatternE(`\d`, "#"); err != nil || got != "a#b##c###" {
+		t.Errorf("Str.ReplacePatternE() = %q, %v", got, err)
+	}
+	if got, err := s.SplitPatternE(`[a-c]`); err != nil || len(got) != 4 {
+		t.Errorf("Str.SplitPatternE() = %q, %v", got, err)
+	}
+
+	for name, fn := range map[string]func() error{
+		"FindE":           func() error { _, err := s.FindE("["); return err },
+		"FindAllE":        func() error { _, err := s.FindAllE("["); return err },
+		"FindIndexE":      func() error { _, err := s.FindIndexE("["); return err },
+		"FindAllIndexE":   func() error { _, err := s.FindAllIndexE("["); return err },
+		"MatchE":          func() error { _, err := s.MatchE("a", "["); return err },
+		"ReplacePatternE": func() error { _, err := s.ReplacePatternE("[", ""); return err },
+		"SplitPatternE":   func() error { _, err := s.SplitPatternE("["); return err },
+	} {
+		if err := fn(); err == nil {
+			t.Errorf("Str.%s() returned no error for an invalid pattern", name)
+		}
+	}
+}
+
+func TestMatchAnyPattern(t *testing.T) {
+	if !Str("dog").Match("cat", "dog") {
+		t.Error("Str.Match() should try every pattern")
+	}
+	if !Str("dog").Match("[", "dog") {
+		t.Error("Str.Match() should skip invalid patterns")
+	}
+}
+
+func TestPanicOnBadPattern(t *testing.T) {
+	SetPanicOnBadPattern(true)
+	defer SetPanicOnBadPattern(false)
+	defer func() {
+		if recover() == nil {
+			t.Error("Str.Find() did not panic on an invalid pattern")
+		}
+	}()
+	Str("test").Find("[")
+}
+
+func TestMustCompile(t *testing.T) {
+	re := MustCompile(`a+?`)
+	if re == MustCompile(`a+?`) {
+		t.Error("MustCompile() returned a shared regexp")
+	}
+	re.Longest()
+	if got := Str("aaa").FindIndex(`a+?`); got[1] != 1 {
+		t.Errorf("Str.FindIndex() = %v after Longest on a copy", got)
+	}
+	if got := MustCompile(`a+?`).FindString("aaa"); got != "a" {
+		t.Errorf("MustCompile().FindString() = %q after Longest on a copy", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustCompile() did not panic on an invalid pattern")
+		}
+	}()
+	MustCompile("[")
+}
@@ -0,0 +1,137 @@
+package str
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Match is a single match of a regular expression, as passed to the callbacks
+// of ReplaceFunc.
+type Match struct {
+	// Text is the text of the whole match.
+	Text Str
+	// Index holds the byte offsets of the match and its groups in the
+	// searched string, in the form returned by
+	// regexp.Regexp.FindStringSubmatchIndex. Groups that did not participate
+	// in the match are -1.
+	Index []int
+
+	src Str
+	re  *regexp.Regexp
+}
+
+func (m Match) Start() int {
+	return m.Index[0]
+}
+
+func (m Match) End() int {
+	return m.Index[1]
+}
+
+// Group returns the text of the nth group, or "" if it did not participate in
+// the match. Group 0 is the whole match.
+func (m Match) Group(n int) Str {
+	if n < 0 || 2*n+1 >= len(m.Index) || m.Index[2*n] < 0 {
+		return ""
+	}
+	return m.src[m.Index[2*n]:m.Index[2*n+1]]
+}
+
+func (m Match) Groups() Array {
+	out := make(Array, len(m.Index)/2)
+	for i := range out {
+		out[i] = m.Group(i)
+	}
+	return out
+}
+
+func (m Match) Named(name string) Str {
+	return m.Group(m.re.SubexpIndex(name))
+}
+
+// NamedGroups returns the named groups that participated in the match.
+func (m Match) NamedGroups() map[string]Str {
+	return namedGroups(m.re, m.src, m.Index)
+}
+
+func namedGroups(re *regexp.Regexp, s Str, loc []int) map[string]Str {
+	out := make(map[string]Str)
+	for i, name := range re.SubexpNames() {
+		if name != "" && loc[2*i] >= 0 {
+			out[name] = s[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return out
+}
+
+func (s Str) ReplaceFunc(pattern string, fn func(Match) Str) Str {
+	return s.ReplaceFuncN(pattern, fn, -1)
+}
+
+// ReplaceFuncN is like ReplaceFunc but replaces at most n matches; n < 0
+// replaces all of them.
+func (s Str) ReplaceFuncN(pattern string, fn func(Match) Str, n int) Str {
+	if re := compilePattern(pattern); re != nil {
+		return s.ReplaceFuncRegexN(re, fn, n)
+	}
+	return s
+}
+
+func (s Str) ReplaceFuncRegex(re *regexp.Regexp, fn func(Match) Str) Str {
+	return s.ReplaceFuncRegexN(re, fn, -1)
+}
+
+func (s Str) ReplaceFuncRegexN(re *regexp.Regexp, fn func(Match) Str, n int) Str {
+	return replaceMatches(s, re, n, func(b *strings.Builder, loc []int) {
+		b.WriteString(string(fn(Match{Text: s[loc[0]:loc[1]], Index: loc, src: s, re: re})))
+	})
+}
+
+// ReplaceLiteralPattern replaces the matches of pattern with new, without
+// expanding $ references.
+func (s Str) ReplaceLiteralPattern(pattern, new string) Str {
+	if re := compilePattern(pattern); re != nil {
+		return s.ReplaceLiteralRegex(re, new)
+	}
+	return s
+}
+
+// ReplaceLiteralRegex replaces the matches of re with new, without expanding
+// $ references.
+func (s Str) ReplaceLiteralRegex(re *regexp.Regexp, new string) Str {
+	return Str(re.ReplaceAllLiteralString(string(s), new))
+}
+
+// ReplacePatternN is like ReplacePattern but replaces at most n matches; n < 0
+// replaces all of them.
+func (s Str) ReplacePatternN(pattern, new string, n int) Str {
+	if re := compilePattern(pattern); re != nil {
+		return s.ReplaceRegexN(re, new, n)
+	}
+	return s
+}
+
+func (s Str) ReplaceRegexN(re *regexp.Regexp, new string, n int) Str {
+	var dst []byte
+	return replaceMatches(s, re, n, func(b *strings.Builder, loc []int) {
+		dst = re.ExpandString(dst[:0], new, string(s), loc)
+		b.Write(dst)
+	})
+}
+
+func replaceMatches(s Str, re *regexp.Regexp, n int, replace func(*strings.Builder, []int)) Str {
+	matches := re.FindAllStringSubmatchIndex(string(s), n)
+	if matches == nil {
+		return s
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range matches {
+		b.WriteString(string(s[last:loc[0]]))
+		replace(&b, loc)
+		last = loc[1]
+	}
+	b.WriteString(string(s[last:]))
+	return Str(b.String())
+}
@@ -0,0 +1,111 @@
+package str
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestReplaceFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		pattern  string
+		fn       func(Match) Str
+		n        int
+		expected Str
+	}{
+		{
+			name:     "case-convert captured identifiers",
+			input:    "user_id=1 created_at=2",
+			pattern:  `(?P<key>\w+)=`,
+			fn:       func(m Match) Str { return m.Named("key").ToCamel() + ":" },
+			n:        -1,
+			expected: "userId:1 createdAt:2",
+		},
+		{
+			name:     "indexes",
+			input:    "a-b-c",
+			pattern:  `-`,
+			fn:       func(m Match) Str { return Str(rune('0' + m.Start())) },
+			n:        -1,
+			expected: "a1b3c",
+		},
+		{
+			name:     "limited",
+			input:    "x x x",
+			pattern:  `x`,
+			fn:       func(m Match) Str { return m.Text.ToUpper() },
+			n:        2,
+			expected: "X X x",
+		},
+		{
+			name:     "zero limit",
+			input:    "x x x",
+			pattern:  `x`,
+			fn:       func(m Match) Str { return "y" },
+			n:        0,
+			expected: "x x x",
+		},
+		{
+			name:     "unmatched group",
+			input:    "v1 v1.2",
+			pattern:  `v(\d+)(?:\.(\d+))?`,
+			fn:       func(m Match) Str { return m.Group(1) + "/" + m.Group(2) + "/" + m.Group(9) },
+			n:        -1,
+			expected: "1// 1/2/",
+		},
+		{
+			name:     "invalid pattern",
+			input:    "abc",
+			pattern:  `(`,
+			fn:       func(m Match) Str { return "" },
+			n:        -1,
+			expected: "abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.ReplaceFuncN(tt.pattern, tt.fn, tt.n); got != tt.expected {
+				t.Errorf("Str.ReplaceFuncN() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	got := Str("k=v").ReplaceFunc(`(?P<k>\w)=(?P<v>\w)`, func(m Match) Str {
+		g := m.NamedGroups()
+		return g["v"] + "=" + g["k"] + Str(rune('0'+len(m.Groups())))
+	})
+	if got != "v=k3" {
+		t.Errorf("Str.ReplaceFunc() = %q, want %q", got, "v=k3")
+	}
+}
+
+func TestReplaceLiteral(t *testing.T) {
+	re := regexp.MustCompile(`(\d+)`)
+	if got := Str("a1b22").ReplaceLiteralRegex(re, "$1$"); got != "a$1$b$1$" {
+		t.Errorf("Str.ReplaceLiteralRegex() = %q", got)
+	}
+	if got := Str("a1b22").ReplaceLiteralPattern(`\d`, "${x}"); got != "a${x}b${x}${x}" {
+		t.Errorf("Str.ReplaceLiteralPattern() = %q", got)
+	}
+}
+
+func TestReplacePatternN(t *testing.T) {
+	tests := []struct {
+		input    Str
+		n        int
+		expected Str
+	}{
+		{"a1 b2 c3", -1, "1a 2b 3c"},
+		{"a1 b2 c3", 1, "1a b2 c3"},
+		{"a1 b2 c3", 0, "a1 b2 c3"},
+		{"a1 b2 c3", 5, "1a 2b 3c"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.ReplacePatternN(`(\w)(\d)`, "$2$1", tt.n); got != tt.expected {
+			t.Errorf("Str(%q).ReplacePatternN(%d) = %q, want %q", tt.input, tt.n, got, tt.expected)
+		}
+	}
+}
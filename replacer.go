@@ -0,0 +1,93 @@
+package str
+
+import (
+	"io"
+	"slices"
+	"strings"
+)
+
+// Replacer replaces a set of strings in a single pass. At each position the
+// longest matching old string wins, and replaced text is never scanned
+// again. A Replacer is safe for concurrent use.
+type Replacer struct {
+	m   *acMatcher
+	new []string
+}
+
+// NewReplacer returns a Replacer from a list of old, new string pairs. Empty
+// old strings are ignored and when an old string appears more than once the
+// first pair wins. It panics if given an odd number of arguments.
+func NewReplacer(oldnew ...string) *Replacer {
+	return newReplacer(oldnew, false)
+}
+
+// NewReplacerFold is like NewReplacer but matches the old strings under
+// Unicode simple case folding.
+func NewReplacerFold(oldnew ...string) *Replacer {
+	return newReplacer(oldnew, true)
+}
+
+func newReplacer(oldnew []string, fold bool) *Replacer {
+	if len(oldnew)%2 == 1 {
+		panic("str: NewReplacer: odd argument count")
+	}
+
+	old := make([]string, len(oldnew)/2)
+	r := &Replacer{new: make([]string, len(oldnew)/2)}
+	for i := range old {
+		old[i], r.new[i] = oldnew[2*i], oldnew[2*i+1]
+	}
+	r.m = newACMatcher(old, fold)
+	return r
+}
+
+func (r *Replacer) Replace(s Str) Str {
+	start, end, p := r.m.next(string(s), 0)
+	if p < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	last := 0
+	for p >= 0 {
+		b.WriteString(string(s[last:start]))
+		b.WriteString(r.new[p])
+		last = end
+		start, end, p = r.m.next(string(s), end)
+	}
+	b.WriteString(string(s[last:]))
+	return Str(b.String())
+}
+
+// WriteString writes s to w with all replacements performed.
+func (r *Replacer) WriteString(w io.Writer, s string) (n int, err error) {
+	last := 0
+	for start, end, p := r.m.next(s, 0); p >= 0; start, end, p = r.m.next(s, end) {
+		for _, part := range [2]string{s[last:start], r.new[p]} {
+			k, err := io.WriteString(w, part)
+			n += k
+			if err != nil {
+				return n, err
+			}
+		}
+		last = end
+	}
+	k, err := io.WriteString(w, s[last:])
+	return n + k, err
+}
+
+// ReplaceAll replaces every key of pairs with its value in a single pass,
+// preferring the longest key at each position.
+func (s Str) ReplaceAll(pairs map[string]string) Str {
+	keys := make([]string, 0, len(pairs))
+	for k := range pairs {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	oldnew := make([]string, 0, 2*len(keys))
+	for _, k := range keys {
+		oldnew = append(oldnew, k, pairs[k])
+	}
+	return NewReplacer(oldnew...).Replace(s)
+}
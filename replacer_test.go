@@ -0,0 +1,174 @@
+package str
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestReplacer(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        *Replacer
+		input    Str
+		expected Str
+	}{
+		{
+			name:     "no cascade",
+			r:        NewReplacer("a", "b", "b", "c"),
+			input:    "abba",
+			expected: "bccb",
+		},
+		{
+			name:     "leftmost longest",
+			r:        NewReplacer("he", "1", "hers", "2", "she", "3"),
+			input:    "ushers",
+			expected: "u3rs",
+		},
+		{
+			name:     "longest at same start",
+			r:        NewReplacer("a", "1", "abc", "2", "ab", "3"),
+			input:    "abcab",
+			expected: "23",
+		},
+		{
+			name:     "longer match starting earlier",
+			r:        NewReplacer("bc", "1", "abcd", "2"),
+			input:    "abcabcd",
+			expected: "a12",
+		},
+		{
+			name:     "first duplicate wins",
+			r:        NewReplacer("x", "1", "x", "2"),
+			input:    "xx",
+			expected: "11",
+		},
+		{
+			name:     "empty old ignored",
+			r:        NewReplacer("", "-", "b", "B"),
+			input:    "abc",
+			expected: "aBc",
+		},
+		{
+			name:     "multi-byte",
+			r:        NewReplacer("ö", "oe", "日本", "Japan"),
+			input:    "schön 日本語",
+			expected: "schoen Japan語",
+		},
+		{
+			name:     "no pairs",
+			r:        NewReplacer(),
+			input:    "abc",
+			expected: "abc",
+		},
+		{
+			name:     "fold",
+			r:        NewReplacerFold("hello", "hi", "straße", "street"),
+			input:    "HeLLo STRASSE Straße",
+			expected: "hi STRASSE street",
+		},
+		{
+			name:     "fold keeps byte offsets",
+			r:        NewReplacerFold("k", "x"),
+			input:    "Ka K k",
+			expected: "xa x x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Replace(tt.input); got != tt.expected {
+				t.Errorf("Replacer.Replace() = %q, want %q", got, tt.expected)
+			}
+
+			var b strings.Builder
+			n, err := tt.r.WriteString(&b, string(tt.input))
+			if err != nil || b.String() != string(tt.expected) || n != len(tt.expected) {
+				t.Errorf("Replacer.WriteString() = %q, %d, %v", b.String(), n, err)
+			}
+		})
+	}
+}
+
+func TestReplacerLeftmostLongest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	word := func(n int) string {
+		b := make([]byte, 1+rng.Intn(n))
+		for i := range b {
+			b[i] = "abc"[rng.Intn(3)]
+		}
+		return string(b)
+	}
+
+	for range 500 {
+		var oldnew []string
+		for i := range 1 + rng.Intn(5) {
+			oldnew = append(oldnew, word(4), strings.Repeat("#", i+1))
+		}
+		input := word(30)
+
+		if got, want := NewReplacer(oldnew...).Replace(Str(input)), naiveReplace(input, oldnew); string(got) != want {
+			t.Fatalf("NewReplacer(%q).Replace(%q) = %q, want %q", oldnew, input, got, want)
+		}
+	}
+}
+
+// naiveReplace replaces the longest old string at each position, the first
+// one listed on ties.
+func naiveReplace(s string, oldnew []string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		best := -1
+		for j := 0; j < len(oldnew); j += 2 {
+			if strings.HasPrefix(s[i:], oldnew[j]) && (best < 0 || len(oldnew[j]) > len(oldnew[best])) {
+				best = j
+			}
+		}
+		if best < 0 {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		b.WriteString(oldnew[best+1])
+		i += len(oldnew[best])
+	}
+	return b.String()
+}
+
+func TestReplacerConcurrent(t *testing.T) {
+	r := NewReplacer("cat", "dog", "dog", "cat")
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if got := r.Replace("cat and dog"); got != "dog and cat" {
+					t.Errorf("Replacer.Replace() = %q", got)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestReplacerOddArguments(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewReplacer() with odd arguments did not panic")
+		}
+	}()
+	NewReplacer("a")
+}
+
+func TestReplaceAll(t *testing.T) {
+	got := Str("{name} is {age}").ReplaceAll(map[string]string{"{name}": "Ada", "{age}": "36", "{": "<"})
+	if got != "Ada is 36" {
+		t.Errorf("Str.ReplaceAll() = %q, want %q", got, "Ada is 36")
+	}
+	if got := Str("abc").ReplaceAll(nil); got != "abc" {
+		t.Errorf("Str.ReplaceAll(nil) = %q", got)
+	}
+}
@@ -0,0 +1,137 @@
+package str
+
+import (
+	"iter"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SplitSeq is like Split but yields the substrings lazily.
+func (s Str) SplitSeq(delim string) iter.Seq[Str] {
+	return func(yield func(Str) bool) {
+		rest := string(s)
+		if delim == "" {
+			for rest != "" {
+				_, n := utf8.DecodeRuneInString(rest)
+				if !yield(Str(rest[:n])) {
+					return
+				}
+				rest = rest[n:]
+			}
+			return
+		}
+
+		for {
+			i := strings.Index(rest, delim)
+			if i < 0 {
+				yield(Str(rest))
+				return
+			}
+			if !yield(Str(rest[:i])) {
+				return
+			}
+			rest = rest[i+len(delim):]
+		}
+	}
+}
+
+// FieldsSeq is like Fields but yields the fields lazily.
+func (s Str) FieldsSeq() iter.Seq[Str] {
+	return func(yield func(Str) bool) {
+		start := -1
+		for i, r := range string(s) {
+			switch space := unicode.IsSpace(r); {
+			case space && start >= 0:
+				if !yield(s[start:i]) {
+					return
+				}
+				start = -1
+			case !space && start < 0:
+				start = i
+			}
+		}
+		if start >= 0 {
+			yield(s[start:])
+		}
+	}
+}
+
+// LinesSeq is like Lines but yields the lines lazily.
+func (s Str) LinesSeq() iter.Seq[Str] {
+	return func(yield func(Str) bool) {
+		for rest := string(s); rest != ""; {
+			var line string
+			line, _, rest = cutLine(rest)
+			if !yield(Str(line)) {
+				return
+			}
+		}
+	}
+}
+
+// RunesSeq yields the runes of s with their byte offsets, as ranging over a
+// string does.
+func (s Str) RunesSeq() iter.Seq2[int, rune] {
+	return func(yield func(int, rune) bool) {
+		for i, r := range string(s) {
+			if !yield(i, r) {
+				return
+			}
+		}
+	}
+}
+
+// MatchesSeq yields the successive matches of re in s, the same ones as
+// FindAllRegex. Matches are searched for in batches of growing size, so
+// stopping early avoids scanning the rest of s.
+func (s Str) MatchesSeq(re *regexp.Regexp) iter.Seq[Match] {
+	return func(yield func(Match) bool) {
+		done := 0
+		for n := 8; ; n *= 2 {
+			matches := re.FindAllStringSubmatchIndex(string(s), n)
+			for _, loc := range matches[done:] {
+				if !yield(Match{Text: s[loc[0]:loc[1]], Index: loc, src: s, re: re}) {
+					return
+				}
+			}
+			if len(matches) < n {
+				return
+			}
+			done = len(matches)
+		}
+	}
+}
+
+// Values yields the elements of s in order.
+func (s Array) Values() iter.Seq[Str] {
+	return func(yield func(Str) bool) {
+		for i := range s {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Entries yields the indexes and elements of s in order, like slices.All.
+// The name All is taken by the predicate check.
+func (s Array) Entries() iter.Seq2[int, Str] {
+	return func(yield func(int, Str) bool) {
+		for i := range s {
+			if !yield(i, s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers the values of seq into an Array.
+func Collect[S ~string](seq iter.Seq[S]) Array {
+	var out Array
+	for v := range seq {
+		out = append(out, Str(v))
+	}
+	return out
+}
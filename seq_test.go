@@ -0,0 +1,125 @@
+package str
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSplitSeq(t *testing.T) {
+	tests := []struct {
+		input Str
+		delim string
+	}{
+		{"a,b,,c", ","},
+		{"", ","},
+		{"abc", ""},
+		{"héllo", ""},
+		{"a--b--", "--"},
+		{"abc", "x"},
+	}
+
+	for _, tt := range tests {
+		if got := Collect(tt.input.SplitSeq(tt.delim)); !slices.Equal(got, tt.input.Split(tt.delim)) {
+			t.Errorf("Str(%q).SplitSeq(%q) = %q, want %q", tt.input, tt.delim, got, tt.input.Split(tt.delim))
+		}
+	}
+}
+
+func TestFieldsSeq(t *testing.T) {
+	for _, input := range []Str{"", "  ", "a", " a  b\tc\n", "日本 語　x"} {
+		if got := Collect(input.FieldsSeq()); !slices.Equal(got, input.Fields()) {
+			t.Errorf("Str(%q).FieldsSeq() = %q, want %q", input, got, input.Fields())
+		}
+	}
+}
+
+func TestLinesSeq(t *testing.T) {
+	input := Str("a\r\nb\rc\n\nd\n")
+	if got := Collect(input.LinesSeq()); !slices.Equal(got, input.Lines()) {
+		t.Errorf("Str.LinesSeq() = %q, want %q", got, input.Lines())
+	}
+}
+
+func TestRunesSeq(t *testing.T) {
+	var offsets []int
+	var runes []rune
+	for i, r := range Str("aé日\xff").RunesSeq() {
+		offsets = append(offsets, i)
+		runes = append(runes, r)
+	}
+	if !slices.Equal(offsets, []int{0, 1, 3, 6}) || string(runes) != "aé日�" {
+		t.Errorf("Str.RunesSeq() = %v, %q", offsets, runes)
+	}
+}
+
+func TestMatchesSeq(t *testing.T) {
+	re := regexp.MustCompile(`(?P<n>\d+)|x*`)
+	input := Str(strings.Repeat("ab12c345x", 7))
+
+	var got []string
+	for m := range input.MatchesSeq(re) {
+		got = append(got, string(m.Text)+"@"+string(rune('0'+m.Start()%10)))
+	}
+	var want []string
+	for _, loc := range re.FindAllStringIndex(string(input), -1) {
+		want = append(want, string(input[loc[0]:loc[1]])+"@"+string(rune('0'+loc[0]%10)))
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Str.MatchesSeq() = %q, want %q", got, want)
+	}
+
+	for m := range input.MatchesSeq(regexp.MustCompile(`\d+`)) {
+		if m.Text != "12" {
+			t.Errorf("Str.MatchesSeq() first match = %q", m.Text)
+		}
+		break
+	}
+}
+
+func TestEarlyStop(t *testing.T) {
+	huge := Str(strings.Repeat("word ", 1000))
+	count := 0
+	for w := range huge.SplitSeq(" ") {
+		if count++; count == 3 || w != "word" {
+			break
+		}
+	}
+	for range huge.FieldsSeq() {
+		break
+	}
+	for range huge.LinesSeq() {
+		break
+	}
+	for range huge.RunesSeq() {
+		break
+	}
+	if count != 3 {
+		t.Errorf("SplitSeq yielded %d values, want 3", count)
+	}
+}
+
+func TestArraySeq(t *testing.T) {
+	a := Array{"x", "y", "z"}
+	if got := Collect(a.Values()); !slices.Equal(got, a) {
+		t.Errorf("Array.Values() = %q", got)
+	}
+
+	var indexes []int
+	for i, v := range a.Entries() {
+		if v != a[i] {
+			t.Errorf("Array.Entries() yielded %d, %q", i, v)
+		}
+		if indexes = append(indexes, i); i == 1 {
+			break
+		}
+	}
+	if !slices.Equal(indexes, []int{0, 1}) {
+		t.Errorf("Array.Entries() yielded indexes %v", indexes)
+	}
+
+	if got := Collect(slices.Values([]string{"a", "b"})); !slices.Equal(got, Array{"a", "b"}) {
+		t.Errorf("Collect() = %q", got)
+	}
+}
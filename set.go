@@ -0,0 +1,133 @@
+package str
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// Unique returns the elements of s without duplicates, keeping the first
+// occurrence of each.
+func (s Array) Unique() Array {
+	return s.uniqueBy(func(v Str) Str { return v })
+}
+
+// UniqueFold is like Unique but compares elements under Unicode simple case
+// folding.
+func (s Array) UniqueFold() Array {
+	return s.uniqueBy(foldKey)
+}
+
+func (s Array) uniqueBy(key func(Str) Str) Array {
+	if s == nil {
+		return nil
+	}
+	seen := make(map[Str]struct{}, len(s))
+	out := make(Array, 0, len(s))
+	for _, v := range s {
+		k := key(v)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// foldKey maps s to a key shared by every string equal to it under simple
+// case folding.
+func foldKey(s Str) Str {
+	return Str(strings.Map(foldRune, string(s)))
+}
+
+// Union returns the elements of s followed by those of others, without
+// duplicates.
+func (s Array) Union(others ...Array) Array {
+	return Flatten(append([]Array{s}, others...)).Unique()
+}
+
+// Intersect returns the elements of s that are also in other, without
+// duplicates.
+func (s Array) Intersect(other Array) Array {
+	set := NewSet(other...)
+	return s.Filter(set.Has).Unique()
+}
+
+// Difference returns the elements of s that are not in other, without
+// duplicates.
+func (s Array) Difference(other Array) Array {
+	set := NewSet(other...)
+	return s.Filter(func(v Str) bool { return !set.Has(v) }).Unique()
+}
+
+// SymmetricDifference returns the elements of s that are not in other
+// followed by those of other that are not in s, without duplicates.
+func (s Array) SymmetricDifference(other Array) Array {
+	return s.Difference(other).Union(other.Difference(s))
+}
+
+// IsSubsetOf reports whether every element of s is in other.
+func (s Array) IsSubsetOf(other Array) bool {
+	set := NewSet(other...)
+	return s.All(set.Has)
+}
+
+// Set is an unordered collection of distinct strings. The zero value is an
+// empty set ready to use. It marshals to JSON as a sorted array.
+type Set struct {
+	items map[Str]struct{}
+}
+
+func NewSet(values ...Str) *Set {
+	s := &Set{items: make(map[Str]struct{}, len(values))}
+	s.Add(values...)
+	return s
+}
+
+func (s *Set) Add(values ...Str) {
+	if s.items == nil {
+		s.items = make(map[Str]struct{}, len(values))
+	}
+	for _, v := range values {
+		s.items[v] = struct{}{}
+	}
+}
+
+func (s *Set) Has(value Str) bool {
+	_, ok := s.items[value]
+	return ok
+}
+
+func (s *Set) Len() int {
+	return len(s.items)
+}
+
+func (s *Set) Remove(values ...Str) {
+	for _, v := range values {
+		delete(s.items, v)
+	}
+}
+
+// Array returns the elements of s in ascending order.
+func (s *Set) Array() Array {
+	out := make(Array, 0, len(s.items))
+	for v := range s.items {
+		out = append(out, v)
+	}
+	slices.Sort(out)
+	return out
+}
+
+func (s Set) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Array())
+}
+
+func (s *Set) UnmarshalJSON(data []byte) error {
+	var values Array
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.items = nil
+	s.Add(values...)
+	return nil
+}
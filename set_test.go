@@ -0,0 +1,83 @@
+package str
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	a := Array{"b", "a", "B", "b", "c", "a"}
+	if got := a.Unique(); !slices.Equal(got, Array{"b", "a", "B", "c"}) {
+		t.Errorf("Array.Unique() = %q", got)
+	}
+	if got := a.UniqueFold(); !slices.Equal(got, Array{"b", "a", "c"}) {
+		t.Errorf("Array.UniqueFold() = %q", got)
+	}
+	if got := (Array{"Σ", "σ", "ς", "K", "k"}).UniqueFold(); !slices.Equal(got, Array{"Σ", "K"}) {
+		t.Errorf("Array.UniqueFold() = %q", got)
+	}
+	if got := Array(nil).Unique(); got != nil {
+		t.Errorf("Array.Unique() = %q, want nil", got)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	allowed := Array{"read", "write", "admin", "read"}
+	requested := Array{"write", "delete", "read"}
+
+	tests := []struct {
+		name     string
+		got      Array
+		expected Array
+	}{
+		{"union", allowed.Union(requested), Array{"read", "write", "admin", "delete"}},
+		{"union of several", Array{"a"}.Union(Array{"b"}, Array{"a", "c"}), Array{"a", "b", "c"}},
+		{"intersect", allowed.Intersect(requested), Array{"read", "write"}},
+		{"difference", requested.Difference(allowed), Array{"delete"}},
+		{"symmetric difference", allowed.SymmetricDifference(requested), Array{"admin", "delete"}},
+		{"empty intersect", allowed.Intersect(nil), nil},
+	}
+
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.expected) {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+
+	if !(Array{"read", "read"}).IsSubsetOf(allowed) || requested.IsSubsetOf(allowed) || !Array(nil).IsSubsetOf(nil) {
+		t.Error("Array.IsSubsetOf() mismatch")
+	}
+}
+
+func TestSet(t *testing.T) {
+	var s Set
+	if s.Has("a") || s.Len() != 0 {
+		t.Error("zero Set is not empty")
+	}
+	s.Add("b", "a", "b")
+	s.Remove("c")
+	if !s.Has("a") || s.Len() != 2 {
+		t.Errorf("Set = %q", s.Array())
+	}
+	s.Remove("a")
+	if s.Has("a") || !slices.Equal(s.Array(), Array{"b"}) {
+		t.Errorf("Set = %q", s.Array())
+	}
+
+	data, err := json.Marshal(struct{ Tags Set }{*NewSet("go", "api", "cli")})
+	if err != nil || string(data) != `{"Tags":["api","cli","go"]}` {
+		t.Errorf("json.Marshal(Set) = %s, %v", data, err)
+	}
+
+	var decoded struct{ Tags *Set }
+	if err := json.Unmarshal([]byte(`{"Tags":["x","y","x"]}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(decoded.Tags.Array(), Array{"x", "y"}) {
+		t.Errorf("json.Unmarshal(Set) = %q", decoded.Tags.Array())
+	}
+	if err := json.Unmarshal([]byte(`{"Tags":"x"}`), &decoded); err == nil {
+		t.Error("json.Unmarshal(Set) error = nil for a string")
+	}
+}
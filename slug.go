@@ -0,0 +1,179 @@
+package str
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var slugTransliterations = map[rune]string{
+	// German and other Latin letters that do not decompose to ASCII.
+	'ä': "ae", 'ö': "oe", 'ü': "ue", 'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o",
+	'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i", 'ħ': "h",
+
+	// Cyrillic.
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i",
+	'ї': "yi", 'ґ': "g", 'ў': "u", 'ј': "j", 'љ': "lj", 'њ': "nj", 'ћ': "c",
+	'џ': "dz", 'ђ': "dj",
+
+	// Greek.
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+	'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+	'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+	'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+type slugOptions struct {
+	separator       string
+	maxLength       int
+	transliteration map[rune]string
+	reserved        map[string]struct{}
+	exists          func(Str) bool
+}
+
+type SlugOption func(*slugOptions)
+
+func WithSlugSeparator(sep string) SlugOption {
+	return func(o *slugOptions) {
+		o.separator = sep
+	}
+}
+
+// WithSlugMaxLength limits the slug to n bytes, cutting at a word boundary
+// whenever the first word fits.
+func WithSlugMaxLength(n int) SlugOption {
+	return func(o *slugOptions) {
+		o.maxLength = n
+	}
+}
+
+// WithSlugTransliterations adds or overrides the ASCII replacements of
+// lower-case runes.
+func WithSlugTransliterations(table map[rune]string) SlugOption {
+	return func(o *slugOptions) {
+		for r, v := range table {
+			o.transliteration[r] = v
+		}
+	}
+}
+
+// WithSlugReserved rejects slugs equal to one of words; a numeric suffix is
+// appended instead.
+func WithSlugReserved(words ...string) SlugOption {
+	return func(o *slugOptions) {
+		for _, w := range words {
+			o.reserved[w] = struct{}{}
+		}
+	}
+}
+
+// WithSlugExists sets a hook reporting slugs that are already taken, such as
+// a database lookup. Taken slugs get a numeric suffix: "title-2", "title-3".
+func WithSlugExists(fn func(Str) bool) SlugOption {
+	return func(o *slugOptions) {
+		o.exists = fn
+	}
+}
+
+func (s Str) Slug(opts ...SlugOption) Str {
+	o := slugOptions{
+		separator:       "-",
+		transliteration: make(map[rune]string),
+		reserved:        make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	words := slugWords(string(s), o.transliteration)
+	slug := truncateSlug(words, o.separator, o.maxLength)
+	for n := 2; o.taken(slug); n++ {
+		suffix := o.separator + strconv.Itoa(n)
+		limit := o.maxLength
+		if limit > 0 {
+			limit = max(limit-len(suffix), 1)
+		}
+		slug = truncateSlug(words, o.separator, limit) + Str(suffix)
+	}
+	return slug
+}
+
+func (o *slugOptions) taken(slug Str) bool {
+	if _, ok := o.reserved[string(slug)]; ok {
+		return true
+	}
+	return o.exists != nil && o.exists(slug)
+}
+
+func slugWords(s string, custom map[rune]string) []string {
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+
+	for _, r := range norm.NFC.String(strings.ToLower(s)) {
+		if r == '\'' || r == '’' {
+			continue
+		}
+		v, ok := custom[r]
+		if !ok {
+			v, ok = slugTransliterations[r]
+		}
+		if !ok {
+			v = asciiFold(r)
+		}
+		if v == "" && !ok {
+			flush()
+			continue
+		}
+		word.WriteString(v)
+	}
+	flush()
+	return words
+}
+
+// asciiFold returns the ASCII letters and digits left after stripping the
+// diacritics from r, or "" if r has no ASCII base.
+func asciiFold(r rune) string {
+	var b strings.Builder
+	for _, d := range norm.NFD.String(string(r)) {
+		switch {
+		case d < unicode.MaxASCII && (unicode.IsLetter(d) || unicode.IsDigit(d)):
+			b.WriteRune(unicode.ToLower(d))
+		case unicode.Is(unicode.Mn, d):
+		default:
+			if v, ok := slugTransliterations[d]; ok {
+				b.WriteString(v)
+			} else {
+				return ""
+			}
+		}
+	}
+	return b.String()
+}
+
+func truncateSlug(words []string, sep string, maxLength int) Str {
+	slug := strings.Join(words, sep)
+	if maxLength <= 0 || len(slug) <= maxLength {
+		return Str(slug)
+	}
+
+	n := len(words[0])
+	if n > maxLength {
+		return Str(slug[:maxLength])
+	}
+	for i := 1; i < len(words) && n+len(sep)+len(words[i]) <= maxLength; i++ {
+		n += len(sep) + len(words[i])
+	}
+	return Str(slug[:n])
+}
@@ -0,0 +1,95 @@
+package str
+
+import "testing"
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		opts     []SlugOption
+		expected string
+	}{
+		{
+			name:     "diacritics and punctuation",
+			input:    "Crème Brûlée — 2025!",
+			expected: "creme-brulee-2025",
+		},
+		{
+			name:     "german umlauts",
+			input:    "Größe über Äpfel",
+			expected: "groesse-ueber-aepfel",
+		},
+		{
+			name:     "cyrillic",
+			input:    "Привет, мир",
+			expected: "privet-mir",
+		},
+		{
+			name:     "greek with accents",
+			input:    "Καλημέρα κόσμε",
+			expected: "kalimera-kosme",
+		},
+		{
+			name:     "apostrophes do not split words",
+			input:    "Don't Stop",
+			expected: "dont-stop",
+		},
+		{
+			name:     "unknown scripts are dropped",
+			input:    "hello 世界 world",
+			expected: "hello-world",
+		},
+		{
+			name:     "custom separator",
+			input:    "Hello World",
+			opts:     []SlugOption{WithSlugSeparator("_")},
+			expected: "hello_world",
+		},
+		{
+			name:     "max length cuts on word boundary",
+			input:    "the quick brown fox",
+			opts:     []SlugOption{WithSlugMaxLength(12)},
+			expected: "the-quick",
+		},
+		{
+			name:     "max length cuts long first word",
+			input:    "supercalifragilistic",
+			opts:     []SlugOption{WithSlugMaxLength(5)},
+			expected: "super",
+		},
+		{
+			name:     "custom transliteration",
+			input:    "Æsir & Ødin",
+			opts:     []SlugOption{WithSlugTransliterations(map[rune]string{'&': "and"})},
+			expected: "aesir-and-odin",
+		},
+		{
+			name:     "reserved word",
+			input:    "Admin",
+			opts:     []SlugOption{WithSlugReserved("admin", "admin-2")},
+			expected: "admin-3",
+		},
+		{
+			name:  "exists hook with max length",
+			input: "hello world",
+			opts: []SlugOption{
+				WithSlugMaxLength(11),
+				WithSlugExists(func(s Str) bool { return s == "hello-world" }),
+			},
+			expected: "hello-2",
+		},
+		{
+			name:     "empty",
+			input:    "!!!",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Slug(tt.opts...); got != Str(tt.expected) {
+				t.Errorf("Str.Slug() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
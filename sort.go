@@ -0,0 +1,135 @@
+package str
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// The Sort methods return a sorted copy of s and leave s unchanged. All of
+// them are stable: elements that compare equal keep their order.
+
+func (s Array) Sort() Array {
+	return s.sortFunc(func(a, b Str) int {
+		return strings.Compare(string(a), string(b))
+	})
+}
+
+// SortBy orders s by the keys fn returns, calling fn once per element.
+func (s Array) SortBy(fn func(Str) Str) Array {
+	if s == nil {
+		return nil
+	}
+	type keyed struct {
+		key, value Str
+	}
+	items := make([]keyed, len(s))
+	for i, v := range s {
+		items[i] = keyed{fn(v), v}
+	}
+	slices.SortStableFunc(items, func(a, b keyed) int {
+		return strings.Compare(string(a.key), string(b.key))
+	})
+
+	out := make(Array, len(s))
+	for i := range items {
+		out[i] = items[i].value
+	}
+	return out
+}
+
+// SortFold orders s ignoring case, under Unicode simple case folding.
+func (s Array) SortFold() Array {
+	return s.sortFunc(compareFold)
+}
+
+// SortLocale orders s by the collation rules of tag, so that for instance
+// "ä" sorts next to "a" in German but after "z" in Swedish.
+func (s Array) SortLocale(tag language.Tag) Array {
+	c := collate.New(tag)
+	return s.sortFunc(func(a, b Str) int {
+		return c.CompareString(string(a), string(b))
+	})
+}
+
+// SortNatural orders s with runs of digits compared by their numeric value,
+// so "file2" sorts before "file10". See Str.CompareNatural.
+func (s Array) SortNatural() Array {
+	return s.sortFunc(func(a, b Str) int {
+		return a.CompareNatural(string(b))
+	})
+}
+
+func (s Array) sortFunc(fn func(a, b Str) int) Array {
+	out := slices.Clone(s)
+	slices.SortStableFunc(out, fn)
+	return out
+}
+
+// BinarySearch searches for target in s, which must be in the order of Sort,
+// and returns the position where it is or would be inserted and whether it
+// was found.
+func (s Array) BinarySearch(target Str) (int, bool) {
+	return slices.BinarySearch(s, target)
+}
+
+// CompareNatural compares s and other like Compare, except that runs of ASCII
+// digits are compared by numeric value. Numbers that are equal but for their
+// leading zeros order the shorter one first.
+func (s Str) CompareNatural(other string) int {
+	a, b := string(s), other
+	zeros := 0
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			da, db := digitRun(a), digitRun(b)
+			na, nb := strings.TrimLeft(a[:da], "0"), strings.TrimLeft(b[:db], "0")
+			if c := cmp.Or(cmp.Compare(len(na), len(nb)), strings.Compare(na, nb)); c != 0 {
+				return c
+			}
+			if zeros == 0 {
+				zeros = cmp.Compare(da, db)
+			}
+			a, b = a[da:], b[db:]
+			continue
+		}
+
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if ra != rb {
+			return cmp.Compare(ra, rb)
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return cmp.Or(cmp.Compare(len(a), len(b)), zeros)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func digitRun(s string) int {
+	n := 0
+	for n < len(s) && isDigit(s[n]) {
+		n++
+	}
+	return n
+}
+
+// compareFold compares a and b rune by rune under simple case folding,
+// ordering letters by their lower case.
+func compareFold(a, b Str) int {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(string(a))
+		rb, nb := utf8.DecodeRuneInString(string(b))
+		if c := cmp.Compare(unicode.ToLower(foldRune(ra)), unicode.ToLower(foldRune(rb))); c != 0 {
+			return c
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return cmp.Compare(len(a), len(b))
+}
@@ -0,0 +1,90 @@
+package str
+
+import (
+	"slices"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestSort(t *testing.T) {
+	a := Array{"file10", "File2", "file2", "_tmp", "file1", "Zeta"}
+	before := slices.Clone(a)
+
+	tests := []struct {
+		name     string
+		got      Array
+		expected Array
+	}{
+		{"Sort", a.Sort(), Array{"File2", "Zeta", "_tmp", "file1", "file10", "file2"}},
+		{"SortFold", a.SortFold(), Array{"_tmp", "file1", "file10", "File2", "file2", "Zeta"}},
+		{"SortNatural", a.SortNatural(), Array{"File2", "Zeta", "_tmp", "file1", "file2", "file10"}},
+		{"SortBy", a.SortBy(Str.ToLower), Array{"_tmp", "file1", "file10", "File2", "file2", "Zeta"}},
+	}
+
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.expected) {
+			t.Errorf("Array.%s() = %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+	if !slices.Equal(a, before) {
+		t.Errorf("sorting modified the array: %q", a)
+	}
+	if got := Array(nil).Sort(); got != nil {
+		t.Errorf("Array.Sort() = %q, want nil", got)
+	}
+}
+
+func TestSortLocale(t *testing.T) {
+	a := Array{"zebra", "äpple", "apple", "Österreich", "orange"}
+
+	if got := a.SortLocale(language.German); !slices.Equal(got, Array{"apple", "äpple", "orange", "Österreich", "zebra"}) {
+		t.Errorf("Array.SortLocale(de) = %q", got)
+	}
+	if got := a.SortLocale(language.Swedish); !slices.Equal(got, Array{"apple", "orange", "zebra", "äpple", "Österreich"}) {
+		t.Errorf("Array.SortLocale(sv) = %q", got)
+	}
+}
+
+func TestCompareNatural(t *testing.T) {
+	tests := []struct {
+		a, b     Str
+		expected int
+	}{
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file2", "file2", 0},
+		{"v1.10.0", "v1.9.3", 1},
+		{"a01", "a1", 1},
+		{"a1", "a01", -1},
+		{"a001b", "a01c", -1},
+		{"x9", "x9y", -1},
+		{"abc", "abd", -1},
+		{"99999999999999999999999", "100000000000000000000000", -1},
+		{"", "0", -1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.CompareNatural(string(tt.b)); got != tt.expected {
+			t.Errorf("Str(%q).CompareNatural(%q) = %d, want %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	a := Array{"b1", "a1", "B2", "a2", "A3"}
+	got := a.SortBy(func(s Str) Str { return s.SliceTo(1).ToLower() })
+	if !slices.Equal(got, Array{"a1", "a2", "A3", "b1", "B2"}) {
+		t.Errorf("Array.SortBy() = %q", got)
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	a := Array{"delta", "alpha", "charlie"}.Sort()
+	if i, ok := a.BinarySearch("charlie"); i != 1 || !ok {
+		t.Errorf("Array.BinarySearch() = %d, %v", i, ok)
+	}
+	if i, ok := a.BinarySearch("bravo"); i != 1 || ok {
+		t.Errorf("Array.BinarySearch() = %d, %v", i, ok)
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"strconv"
 	"strings"
 	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
 // var _ json.Marshaler = (*Str)(nil)
@@ -142,28 +145,28 @@ func (s Str) FieldsFunc(fn func(rune) bool) Array {
 }
 
 func (s Str) Find(pattern string) Str {
-	if re, err := regexp.Compile(pattern); err == nil {
+	if re := compilePattern(pattern); re != nil {
 		return s.FindRegex(re)
 	}
 	return ""
 }
 
 func (s Str) FindAll(pattern string) Array {
-	if re, err := regexp.Compile(pattern); err == nil {
+	if re := compilePattern(pattern); re != nil {
 		return s.FindAllRegex(re)
 	}
 	return nil
 }
 
 func (s Str) FindAllIndex(pattern string) [][]int {
-	if re, err := regexp.Compile(pattern); err == nil {
+	if re := compilePattern(pattern); re != nil {
 		return s.FindAllIndexRegex(re)
 	}
 	return nil
 }
 
 func (s Str) FindIndex(pattern string) []int {
-	if re, err := regexp.Compile(pattern); err == nil {
+	if re := compilePattern(pattern); re != nil {
 		return s.FindIndexRegex(re)
 	}
 	return nil
@@ -237,8 +240,8 @@ func (s Str) Map(fn func(rune) rune) Str {
 
 func (s Str) Match(patterns ...string) bool {
 	for i := range patterns {
-		if re, err := regexp.Compile(patterns[i]); err == nil {
-			return s.MatchRegex(re)
+		if re := compilePattern(patterns[i]); re != nil && re.MatchString(string(s)) {
+			return true
 		}
 	}
 	return false
@@ -367,7 +370,7 @@ func (s Str) ReplaceN(old, new string, n int) Str {
 }
 
 func (s Str) ReplacePattern(pattern, new string) Str {
-	if re, err := regexp.Compile(pattern); err == nil {
+	if re := compilePattern(pattern); re != nil {
 		return s.ReplaceRegex(re, new)
 	}
 	return s
@@ -487,7 +490,7 @@ func (s Str) SplitN(delim string, count int) Array {
 }
 
 func (s Str) SplitPattern(pattern string) Array {
-	if re, err := regexp.Compile(pattern); err == nil {
+	if re := compilePattern(pattern); re != nil {
 		return s.SplitRegex(re)
 	}
 	return Array{s}
@@ -498,51 +501,63 @@ func (s Str) SplitRegex(regex *regexp.Regexp) Array {
 }
 
 func (s Str) ToCamel() Str {
-	if first, remaining := s.ToPascal().PopStart(); first != -1 {
-		return Str(strings.ToLower(string(first)) + string(remaining))
-	}
-	return s
+	return defaultCaser.Camel(s)
+}
+
+func (s Str) ToCamelIn(tag language.Tag) Str {
+	return defaultCaser.lowerFirst(defaultCaser.pascal(s, tag), tag)
 }
 
 func (s Str) ToGoCamel() Str {
-	if first, remaining := s.ToGoPascal().PopStart(); first != -1 {
-		return Str(strings.ToLower(string(first)) + string(remaining))
-	}
-	return s
+	return defaultCaser.GoCamel(s)
 }
 
 func (s Str) ToGoPascal() Str {
-	words := splitWords(string(s))
-	for i := range words {
-		words[i] = strings.ToLower(words[i])
-	}
-	return Str(goPascalWords(words))
+	return defaultCaser.GoPascal(s)
 }
 
 func (s Str) ToKebab() Str {
-	words := splitWords(string(s))
-	return Str("-").Join(words).ToLower()
+	return defaultCaser.Kebab(s)
 }
 
 func (s Str) ToLower() Str {
 	return Str(strings.ToLower(string(s)))
 }
 
+func (s Str) ToLowerIn(tag language.Tag) Str {
+	return Str(cases.Lower(tag).String(string(s)))
+}
+
 func (s Str) ToPascal() Str {
-	return Str(pascalWords(splitWords(string(s))))
+	return defaultCaser.Pascal(s)
+}
+
+func (s Str) ToPascalIn(tag language.Tag) Str {
+	return defaultCaser.pascal(s, tag)
 }
 
 func (s Str) ToSnake() Str {
-	words := splitWords(string(s))
-	return Str("_").Join(words).ToLower()
+	return defaultCaser.Snake(s)
+}
+
+func (s Str) ToTitle() Str {
+	return s.ToTitleIn(language.Und)
+}
+
+func (s Str) ToTitleIn(tag language.Tag) Str {
+	return Str(cases.Title(tag).String(string(s)))
 }
 
 func (s Str) ToUpper() Str {
 	return Str(strings.ToUpper(string(s)))
 }
 
+func (s Str) ToUpperIn(tag language.Tag) Str {
+	return Str(cases.Upper(tag).String(string(s)))
+}
+
 func (s Str) ToUpperSnake() Str {
-	return s.ToSnake().ToUpper()
+	return defaultCaser.UpperSnake(s)
 }
 
 func (s Str) Trim() Str {
@@ -574,6 +589,10 @@ func (s Str) TrimValue(values ...string) Str {
 	return s
 }
 
+func (s Str) Words() Array {
+	return defaultCaser.Words(s)
+}
+
 func (s Str) MarshalJSON() ([]byte, error) {
 	return json.Marshal(string(s))
 }
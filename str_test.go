@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	"golang.org/x/text/language"
 )
 
 func TestNew(t *testing.T) {
@@ -381,6 +383,102 @@ func TestToPascal(t *testing.T) {
 	}
 }
 
+func TestToCaseIn(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		convert  func(Str, language.Tag) Str
+		tag      language.Tag
+		expected string
+	}{
+		{
+			name:     "turkish upper dotted i",
+			input:    "istanbul ıi",
+			convert:  Str.ToUpperIn,
+			tag:      language.Turkish,
+			expected: "İSTANBUL Iİ",
+		},
+		{
+			name:     "turkish lower dotless i",
+			input:    "İSTANBUL I",
+			convert:  Str.ToLowerIn,
+			tag:      language.Turkish,
+			expected: "istanbul ı",
+		},
+		{
+			name:     "lithuanian lower keeps dot above accents",
+			input:    "ÌÍĨ",
+			convert:  Str.ToLowerIn,
+			tag:      language.Lithuanian,
+			expected: "i\u0307\u0300i\u0307\u0301i\u0307\u0303",
+		},
+		{
+			name:     "lithuanian upper removes dot above",
+			input:    "i\u0307\u0300",
+			convert:  Str.ToUpperIn,
+			tag:      language.Lithuanian,
+			expected: "Ì",
+		},
+		{
+			name:     "greek final sigma",
+			input:    "ΟΔΟΣ ΣΑΣ",
+			convert:  Str.ToLowerIn,
+			tag:      language.Greek,
+			expected: "οδος σας",
+		},
+		{
+			name:     "greek upper drops accents",
+			input:    "όδός",
+			convert:  Str.ToUpperIn,
+			tag:      language.Greek,
+			expected: "ΟΔΟΣ",
+		},
+		{
+			name:     "dutch title ij",
+			input:    "ijsselmeer ijmuiden",
+			convert:  Str.ToTitleIn,
+			tag:      language.Dutch,
+			expected: "IJsselmeer IJmuiden",
+		},
+		{
+			name:     "dutch pascal ij",
+			input:    "ijssel meer",
+			convert:  Str.ToPascalIn,
+			tag:      language.Dutch,
+			expected: "IJsselMeer",
+		},
+		{
+			name:     "turkish pascal",
+			input:    "istanbul ili",
+			convert:  Str.ToPascalIn,
+			tag:      language.Turkish,
+			expected: "İstanbulİli",
+		},
+		{
+			name:     "turkish camel",
+			input:    "IRMAK ismi",
+			convert:  Str.ToCamelIn,
+			tag:      language.Turkish,
+			expected: "ırmakİsmi",
+		},
+		{
+			name:     "english pascal",
+			input:    "hello_world",
+			convert:  Str.ToPascalIn,
+			tag:      language.English,
+			expected: "HelloWorld",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.convert(tt.input, tt.tag); got != Str(tt.expected) {
+				t.Errorf("Str.To*In(%v) = %q, want %q", tt.tag, got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestToKebab(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,324 @@
+package str
+
+import (
+	"io"
+	"regexp"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/transform"
+)
+
+const defaultStreamWindow = 64 << 10
+
+// Stream applies Str operations to text read from an io.Reader, writing the
+// result to an io.Writer without holding the whole text in memory. Each
+// method consumes the rest of the reader.
+type Stream struct {
+	r      io.Reader
+	window int
+}
+
+type StreamOption func(*Stream)
+
+// WithStreamWindow sets how many bytes FindRegex keeps in memory, 64 KiB by
+// default. Longer matches are not found.
+func WithStreamWindow(n int) StreamOption {
+	return func(s *Stream) {
+		s.window = max(n, utf8.UTFMax)
+	}
+}
+
+func NewStream(r io.Reader, opts ...StreamOption) *Stream {
+	s := &Stream{r: r, window: defaultStreamWindow}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Transform copies the text to w through t and returns the number of bytes
+// written.
+func (s *Stream) Transform(w io.Writer, t transform.Transformer) (int64, error) {
+	return io.Copy(w, transform.NewReader(s.r, t))
+}
+
+// Replace replaces every occurrence of old with new. Occurrences spanning
+// the reads from the underlying reader are replaced too.
+func (s *Stream) Replace(w io.Writer, old, new string) (int64, error) {
+	return s.ReplaceWith(w, NewReplacer(old, new))
+}
+
+// ReplaceWith applies r, with the same results as Replacer.Replace on the
+// whole text. Old strings must be shorter than 4 KiB.
+func (s *Stream) ReplaceWith(w io.Writer, r *Replacer) (int64, error) {
+	return s.Transform(w, &replaceTransformer{r: r})
+}
+
+// Map replaces every rune with the result of fn, dropping it if fn returns a
+// negative value.
+func (s *Stream) Map(w io.Writer, fn func(rune) rune) (int64, error) {
+	return s.Transform(w, mapTransformer(fn))
+}
+
+// TrimLinesFunc removes the leading and trailing runes satisfying fn from
+// every line.
+func (s *Stream) TrimLinesFunc(w io.Writer, fn func(rune) bool) (int64, error) {
+	return s.Transform(w, &trimLinesTransformer{fn: fn, lineStart: true})
+}
+
+func (s *Stream) ToLower(w io.Writer) (int64, error) {
+	return s.Transform(w, cases.Lower(language.Und))
+}
+
+func (s *Stream) ToUpper(w io.Writer) (int64, error) {
+	return s.Transform(w, cases.Upper(language.Und))
+}
+
+func (s *Stream) ToLowerIn(w io.Writer, tag language.Tag) (int64, error) {
+	return s.Transform(w, cases.Lower(tag))
+}
+
+func (s *Stream) ToUpperIn(w io.Writer, tag language.Tag) (int64, error) {
+	return s.Transform(w, cases.Upper(tag))
+}
+
+// FindPattern is like FindRegex but compiles pattern first.
+func (s *Stream) FindPattern(pattern string, fn func(match Str, offset int64) bool) error {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return err
+	}
+	return s.FindRegex(re, fn)
+}
+
+// FindRegex calls fn with every match of re and its byte offset in the text
+// until fn returns false. The matches are those FindAllRegex would return on
+// the whole text, provided none is longer than the stream window.
+func (s *Stream) FindRegex(re *regexp.Regexp, fn func(match Str, offset int64) bool) error {
+	// after matches re right after one rune of context, so that a search can
+	// resume in the middle of buf with assertions such as \b still seeing the
+	// text before it.
+	after, err := regexp.Compile(`(?s:.)(` + re.String() + `)`)
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+	base := int64(0) // offset of buf[0] in the text
+	pos, prevEnd := 0, -1
+	chunk := make([]byte, s.window)
+	for {
+		n, err := io.ReadFull(s.r, chunk)
+		buf = append(buf, chunk[:n]...)
+		eof := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !eof {
+			return err
+		}
+
+		// Matches starting a window or more before the end of buf cannot
+		// change with more text; later ones wait for the next read.
+		limit := len(buf) + 1
+		if !eof {
+			limit = len(buf) - s.window
+		}
+		for pos <= len(buf) {
+			loc := findRegexAt(re, after, buf, pos)
+			if loc == nil || loc[0] >= limit {
+				break
+			}
+
+			// Mirror regexp's rule of skipping an empty match that abuts the
+			// previous one.
+			accept := true
+			if loc[1] == pos {
+				accept = loc[0] != prevEnd
+				if pos < len(buf) {
+					_, size := utf8.DecodeRune(buf[pos:])
+					pos += size
+				} else {
+					pos++
+				}
+			} else {
+				pos = loc[1]
+			}
+			prevEnd = loc[1]
+			if accept && !fn(Str(buf[loc[0]:loc[1]]), base+int64(loc[0])) {
+				return nil
+			}
+		}
+		if eof {
+			return nil
+		}
+
+		// Nothing before limit can start a match any more: drop it, keeping
+		// one rune of context.
+		pos = max(pos, limit)
+		for pos > 0 && pos < len(buf) && !utf8.RuneStart(buf[pos]) {
+			pos--
+		}
+		drop := pos
+		if drop > 0 {
+			_, size := utf8.DecodeLastRune(buf[:pos])
+			drop -= size
+		}
+		buf = append(buf[:0], buf[drop:]...)
+		base += int64(drop)
+		pos -= drop
+		if prevEnd -= drop; prevEnd < 0 {
+			prevEnd = -1
+		}
+	}
+}
+
+// findRegexAt returns the leftmost match of re in buf starting at or after
+// pos, with the text before pos as context.
+func findRegexAt(re, after *regexp.Regexp, buf []byte, pos int) []int {
+	if pos == 0 {
+		return re.FindIndex(buf)
+	}
+	_, size := utf8.DecodeLastRune(buf[:pos])
+	start := pos - size
+	loc := after.FindSubmatchIndex(buf[start:])
+	if loc == nil {
+		return nil
+	}
+	return []int{start + loc[2], start + loc[3]}
+}
+
+type replaceTransformer struct {
+	r *Replacer
+}
+
+func (t *replaceTransformer) Reset() {}
+
+func (t *replaceTransformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	s := string(src)
+	safe := len(s)
+	if !atEOF {
+		// Hold back an incomplete final rune along with any text that may
+		// start a match continuing into the next read.
+		if i := lastRuneStart(s); !utf8.FullRuneInString(s[i:]) {
+			s = s[:i]
+		}
+		safe = t.r.m.tail(s)
+	}
+
+	for start, end, p := t.r.m.next(s, 0); p >= 0 && start < safe; start, end, p = t.r.m.next(s, end) {
+		n := copy(dst[nDst:], s[nSrc:start])
+		nDst += n
+		if nSrc += n; nSrc < start || len(dst)-nDst < len(t.r.new[p]) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], t.r.new[p])
+		nSrc = end
+	}
+
+	if nSrc < safe {
+		n := copy(dst[nDst:], s[nSrc:safe])
+		nDst += n
+		if nSrc += n; nSrc < safe {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+	}
+	if nSrc < len(src) {
+		err = transform.ErrShortSrc
+	}
+	return nDst, nSrc, err
+}
+
+// lastRuneStart returns the offset of the last rune start among the final
+// utf8.UTFMax bytes of s, or len(s) if there is none.
+func lastRuneStart(s string) int {
+	for i := len(s) - 1; i >= max(len(s)-utf8.UTFMax, 0); i-- {
+		if utf8.RuneStart(s[i]) {
+			return i
+		}
+	}
+	return len(s)
+}
+
+// mapTransformer is like runes.Map but drops the runes mapped to a negative
+// value, as strings.Map does.
+type mapTransformer func(rune) rune
+
+func (t mapTransformer) Reset() {}
+
+func (t mapTransformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, size := utf8.DecodeRune(src[nSrc:])
+		if r == utf8.RuneError && size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		if r = t(r); r >= 0 {
+			if !utf8.ValidRune(r) {
+				r = utf8.RuneError
+			}
+			if len(dst)-nDst < utf8.RuneLen(r) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += utf8.EncodeRune(dst[nDst:], r)
+		}
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// trimLinesTransformer trims the runes satisfying fn at both ends of every
+// line ending in "\n" or "\r\n". A run of them within a line is held back
+// until the rest of the line shows whether it is trailing, and may then take
+// several calls to write out.
+type trimLinesTransformer struct {
+	fn        func(rune) bool
+	lineStart bool
+	pending   []byte
+	written   int // bytes of pending already written
+}
+
+func (t *trimLinesTransformer) Reset() {
+	t.lineStart, t.pending, t.written = true, nil, 0
+}
+
+func (t *trimLinesTransformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, size := utf8.DecodeRune(src[nSrc:])
+		if r == utf8.RuneError && size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		if r == '\r' {
+			switch {
+			case nSrc+1 < len(src):
+				if src[nSrc+1] == '\n' {
+					r, size = '\n', 2
+				}
+			case !atEOF:
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+		}
+
+		switch {
+		case r == '\n':
+			if len(dst)-nDst < size {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+			t.lineStart, t.pending = true, t.pending[:0]
+		case t.fn(r):
+			if !t.lineStart {
+				t.pending = append(t.pending, src[nSrc:nSrc+size]...)
+			}
+		default:
+			n := copy(dst[nDst:], t.pending[t.written:])
+			nDst += n
+			if t.written += n; t.written < len(t.pending) || len(dst)-nDst < size {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+			t.lineStart, t.pending, t.written = false, t.pending[:0], 0
+		}
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
@@ -0,0 +1,168 @@
+package str
+
+import (
+	"errors"
+	"math/rand"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode"
+)
+
+func randomText(rng *rand.Rand, n int, alphabet string) string {
+	runes := []rune(alphabet)
+	var b strings.Builder
+	for range n {
+		b.WriteRune(runes[rng.Intn(len(runes))])
+	}
+	return b.String()
+}
+
+func TestStreamReplace(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	r := NewReplacer("ab", "X", "abba", "Y", "bé", "", "a", "aa")
+	for _, n := range []int{0, 10, 5000, 20000} {
+		input := randomText(rng, n, "abé ")
+		var b strings.Builder
+		written, err := NewStream(iotest.HalfReader(strings.NewReader(input))).ReplaceWith(&b, r)
+		if want := r.Replace(Str(input)); err != nil || b.String() != string(want) || written != int64(len(want)) {
+			t.Fatalf("Stream.ReplaceWith() on %d runes = %d, %v, output differs from Replacer.Replace", n, written, err)
+		}
+	}
+
+	var b strings.Builder
+	if _, err := NewStream(iotest.OneByteReader(strings.NewReader("foo bar foo"))).Replace(&b, "foo", "baz"); err != nil || b.String() != "baz bar baz" {
+		t.Errorf("Stream.Replace() = %q, %v", b.String(), err)
+	}
+
+	// The default transform buffer holds 4096 bytes, so these needles are
+	// split inside the "é".
+	for _, old := range []string{"é", "aé", "éy"} {
+		input := strings.Repeat("x", 4094) + "aéyy"
+		b.Reset()
+		if _, err := NewStream(strings.NewReader(input)).Replace(&b, old, "E"); err != nil || b.String() != strings.Replace(input, old, "E", 1) {
+			t.Errorf("Stream.Replace(%q) across the buffer boundary = %q, %v", old, b.String()[4090:], err)
+		}
+	}
+}
+
+func TestStreamMap(t *testing.T) {
+	input := "Hello, 世界! héllo"
+	fn := func(r rune) rune {
+		if unicode.IsPunct(r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}
+
+	var b strings.Builder
+	if _, err := NewStream(iotest.OneByteReader(strings.NewReader(input))).Map(&b, fn); err != nil || b.String() != strings.Map(fn, input) {
+		t.Errorf("Stream.Map() = %q, %v", b.String(), err)
+	}
+}
+
+func TestStreamCase(t *testing.T) {
+	input := "straße ǆ İstanbul"
+
+	var b strings.Builder
+	if _, err := NewStream(iotest.OneByteReader(strings.NewReader(input))).ToUpper(&b); err != nil || b.String() != "STRASSE Ǆ İSTANBUL" {
+		t.Errorf("Stream.ToUpper() = %q, %v", b.String(), err)
+	}
+
+	b.Reset()
+	if _, err := NewStream(strings.NewReader("ÇAĞRI")).ToLower(&b); err != nil || b.String() != "çağri" {
+		t.Errorf("Stream.ToLower() = %q, %v", b.String(), err)
+	}
+}
+
+func TestStreamTrimLinesFunc(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 50 {
+		input := randomText(rng, 200, "ab  \t\n\r")
+
+		var b strings.Builder
+		if _, err := NewStream(iotest.OneByteReader(strings.NewReader(input))).TrimLinesFunc(&b, unicode.IsSpace); err != nil {
+			t.Fatal(err)
+		}
+
+		var want strings.Builder
+		for _, line := range strings.SplitAfter(input, "\n") {
+			body, term := strings.CutSuffix(line, "\n")
+			if term {
+				if b, ok := strings.CutSuffix(body, "\r"); ok {
+					body = b
+					want.WriteString(strings.TrimSpace(body) + "\r\n")
+					continue
+				}
+				want.WriteString(strings.TrimSpace(body) + "\n")
+				continue
+			}
+			want.WriteString(strings.TrimSpace(body))
+		}
+		if b.String() != want.String() {
+			t.Fatalf("Stream.TrimLinesFunc(%q) = %q, want %q", input, b.String(), want.String())
+		}
+	}
+
+	// Inner runs longer than the 4096-byte transform buffer.
+	for _, input := range []string{"a" + strings.Repeat(" ", 10000) + "b\n", " a" + strings.Repeat(" ", 10000) + "b" + strings.Repeat(" ", 5000)} {
+		var b strings.Builder
+		if _, err := NewStream(strings.NewReader(input)).TrimLinesFunc(&b, unicode.IsSpace); err != nil || b.String() != strings.TrimLeft(strings.TrimRight(input, " "), " ") {
+			t.Errorf("Stream.TrimLinesFunc() on a %d-byte line = %d bytes, %v", len(input), b.Len(), err)
+		}
+	}
+}
+
+func TestStreamFindRegex(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	patterns := []string{`a+b?`, `\bab`, `^a`, `(?m)^b`, `b*`, `é.`, `a$`}
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(pattern)
+		for range 20 {
+			input := randomText(rng, 300, "abé \n")
+
+			var got [][]int
+			err := NewStream(iotest.HalfReader(strings.NewReader(input)), WithStreamWindow(16)).FindRegex(re, func(m Str, offset int64) bool {
+				got = append(got, []int{int(offset), int(offset) + len(m)})
+				if input[offset:int(offset)+len(m)] != string(m) {
+					t.Fatalf("match %q at %d does not match the input", m, offset)
+				}
+				return true
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := re.FindAllStringIndex(input, -1)
+			if len(got) != len(want) {
+				t.Fatalf("Stream.FindRegex(%q) found %d matches in %q, want %d", pattern, len(got), input, len(want))
+			}
+			for i := range want {
+				if got[i][0] != want[i][0] || got[i][1] != want[i][1] {
+					t.Fatalf("Stream.FindRegex(%q) match %d = %v, want %v in %q", pattern, i, got[i], want[i], input)
+				}
+			}
+		}
+	}
+}
+
+func TestStreamFindPattern(t *testing.T) {
+	var found []Str
+	err := NewStream(strings.NewReader("id=1 id=22 id=333")).FindPattern(`id=\d+`, func(m Str, _ int64) bool {
+		found = append(found, m)
+		return len(found) < 2
+	})
+	if err != nil || len(found) != 2 || found[1] != "id=22" {
+		t.Errorf("Stream.FindPattern() = %q, %v", found, err)
+	}
+
+	if err := NewStream(strings.NewReader("")).FindPattern(`(`, nil); err == nil {
+		t.Error("Stream.FindPattern() error = nil for invalid pattern")
+	}
+
+	readErr := errors.New("read failed")
+	if err := NewStream(iotest.ErrReader(readErr)).FindPattern(`a`, nil); !errors.Is(err, readErr) {
+		t.Errorf("Stream.FindPattern() error = %v, want %v", err, readErr)
+	}
+}
@@ -1,5 +1,7 @@
 package str
 
+import "iter"
+
 type Array []Str
 
 func NewArray[T ~[]E, E ~string | ~rune | ~byte | []byte](in T) Array {
@@ -21,3 +23,216 @@ func (s Array) Strings() []string {
 	}
 	return out
 }
+
+func (s Array) All(fn func(Str) bool) bool {
+	for i := range s {
+		if !fn(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Array) Any(fn func(Str) bool) bool {
+	return s.FindIndex(fn) >= 0
+}
+
+// Chunk splits s into consecutive sub-slices of n elements, the last one
+// possibly shorter. The chunks share s's backing array. It returns nil if
+// n < 1.
+func (s Array) Chunk(n int) []Array {
+	if n < 1 {
+		return nil
+	}
+	out := make([]Array, 0, (len(s)+n-1)/n)
+	for chunk := range s.ChunkSeq(n) {
+		out = append(out, chunk)
+	}
+	return out
+}
+
+// ChunkSeq is like Chunk but yields the chunks one at a time.
+func (s Array) ChunkSeq(n int) iter.Seq[Array] {
+	return func(yield func(Array) bool) {
+		if n < 1 {
+			return
+		}
+		for i := 0; i < len(s); i += n {
+			end := min(i+n, len(s))
+			if !yield(s[i:end:end]) {
+				return
+			}
+		}
+	}
+}
+
+func (s Array) Each(fn func(int, Str)) {
+	for i := range s {
+		fn(i, s[i])
+	}
+}
+
+func (s Array) Filter(fn func(Str) bool) Array {
+	var out Array
+	for v := range s.FilterSeq(fn) {
+		out = append(out, v)
+	}
+	return out
+}
+
+func (s Array) FilterSeq(fn func(Str) bool) iter.Seq[Str] {
+	return func(yield func(Str) bool) {
+		for i := range s {
+			if fn(s[i]) && !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first element satisfying fn.
+func (s Array) Find(fn func(Str) bool) (Str, bool) {
+	if i := s.FindIndex(fn); i >= 0 {
+		return s[i], true
+	}
+	return "", false
+}
+
+func (s Array) FindIndex(fn func(Str) bool) int {
+	for i := range s {
+		if fn(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Flatten concatenates arrays into a single Array.
+func Flatten(arrays []Array) Array {
+	n := 0
+	for i := range arrays {
+		n += len(arrays[i])
+	}
+	out := make(Array, 0, n)
+	for i := range arrays {
+		out = append(out, arrays[i]...)
+	}
+	return out
+}
+
+// GroupBy groups the elements of s by the key fn returns for them, keeping
+// their order within each group.
+func (s Array) GroupBy(fn func(Str) Str) map[Str]Array {
+	out := make(map[Str]Array)
+	for i := range s {
+		key := fn(s[i])
+		out[key] = append(out[key], s[i])
+	}
+	return out
+}
+
+func (s Array) Map(fn func(Str) Str) Array {
+	if s == nil {
+		return nil
+	}
+	out := make(Array, len(s))
+	for i := range s {
+		out[i] = fn(s[i])
+	}
+	return out
+}
+
+func (s Array) MapSeq(fn func(Str) Str) iter.Seq[Str] {
+	return func(yield func(Str) bool) {
+		for i := range s {
+			if !yield(fn(s[i])) {
+				return
+			}
+		}
+	}
+}
+
+// MapTo is like Array.Map for functions returning another type.
+func MapTo[T any](s Array, fn func(Str) T) []T {
+	if s == nil {
+		return nil
+	}
+	out := make([]T, len(s))
+	for i := range s {
+		out[i] = fn(s[i])
+	}
+	return out
+}
+
+// Partition splits s into the elements satisfying fn and the others.
+func (s Array) Partition(fn func(Str) bool) (matched, rest Array) {
+	for i := range s {
+		if fn(s[i]) {
+			matched = append(matched, s[i])
+		} else {
+			rest = append(rest, s[i])
+		}
+	}
+	return matched, rest
+}
+
+// Reduce folds s into a single value, calling fn with the result so far,
+// starting from init, and each element in turn.
+func (s Array) Reduce(init Str, fn func(acc, v Str) Str) Str {
+	return ReduceTo(s, init, fn)
+}
+
+// ReduceTo is like Array.Reduce for results of another type.
+func ReduceTo[T any](s Array, init T, fn func(acc T, v Str) T) T {
+	for i := range s {
+		init = fn(init, s[i])
+	}
+	return init
+}
+
+// Window returns every run of n consecutive elements of s, sharing s's
+// backing array. It returns nil if n < 1 or s is shorter than n.
+func (s Array) Window(n int) []Array {
+	if n < 1 || n > len(s) {
+		return nil
+	}
+	out := make([]Array, 0, len(s)-n+1)
+	for w := range s.WindowSeq(n) {
+		out = append(out, w)
+	}
+	return out
+}
+
+// WindowSeq is like Window but yields the windows one at a time.
+func (s Array) WindowSeq(n int) iter.Seq[Array] {
+	return func(yield func(Array) bool) {
+		if n < 1 {
+			return
+		}
+		for i := 0; i+n <= len(s); i++ {
+			if !yield(s[i : i+n : i+n]) {
+				return
+			}
+		}
+	}
+}
+
+// Zip pairs the elements of s and other by index, stopping at the end of the
+// shorter one.
+func (s Array) Zip(other Array) [][2]Str {
+	out := make([][2]Str, 0, min(len(s), len(other)))
+	for a, b := range s.ZipSeq(other) {
+		out = append(out, [2]Str{a, b})
+	}
+	return out
+}
+
+func (s Array) ZipSeq(other Array) iter.Seq2[Str, Str] {
+	return func(yield func(Str, Str) bool) {
+		for i := range min(len(s), len(other)) {
+			if !yield(s[i], other[i]) {
+				return
+			}
+		}
+	}
+}
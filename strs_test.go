@@ -0,0 +1,114 @@
+package str
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestArrayPredicates(t *testing.T) {
+	a := Array{"apple", "banana", "cherry", "avocado"}
+	startsWithA := func(s Str) bool { return s.HasPrefix("a") }
+
+	if got := a.Filter(startsWithA); !slices.Equal(got, Array{"apple", "avocado"}) {
+		t.Errorf("Array.Filter() = %q", got)
+	}
+	if got := a.Filter(func(Str) bool { return false }); got != nil {
+		t.Errorf("Array.Filter() = %q, want nil", got)
+	}
+	if got, ok := a.Find(func(s Str) bool { return s.Len() == 6 }); got != "banana" || !ok {
+		t.Errorf("Array.Find() = %q, %v", got, ok)
+	}
+	if got, ok := a.Find(func(s Str) bool { return s == "kiwi" }); got != "" || ok {
+		t.Errorf("Array.Find() = %q, %v", got, ok)
+	}
+	if got := a.FindIndex(func(s Str) bool { return s.Contains("err") }); got != 2 {
+		t.Errorf("Array.FindIndex() = %d, want 2", got)
+	}
+	if !a.Any(startsWithA) || a.All(startsWithA) {
+		t.Error("Array.Any() or Array.All() mismatch")
+	}
+	if !(Array{}).All(startsWithA) || (Array{}).Any(startsWithA) {
+		t.Error("Array.Any() or Array.All() mismatch on empty array")
+	}
+
+	matched, rest := a.Partition(startsWithA)
+	if !slices.Equal(matched, Array{"apple", "avocado"}) || !slices.Equal(rest, Array{"banana", "cherry"}) {
+		t.Errorf("Array.Partition() = %q, %q", matched, rest)
+	}
+
+	groups := a.GroupBy(func(s Str) Str { return s.SliceTo(1) })
+	expected := map[Str]Array{"a": {"apple", "avocado"}, "b": {"banana"}, "c": {"cherry"}}
+	if !maps.EqualFunc(groups, expected, slices.Equal) {
+		t.Errorf("Array.GroupBy() = %q", groups)
+	}
+}
+
+func TestArrayTransforms(t *testing.T) {
+	a := Array{"a", "bb", "ccc"}
+
+	if got := a.Map(Str.ToUpper); !slices.Equal(got, Array{"A", "BB", "CCC"}) {
+		t.Errorf("Array.Map() = %q", got)
+	}
+	if got := slices.Collect(a.MapSeq(Str.ToUpper)); !slices.Equal(got, []Str{"A", "BB", "CCC"}) {
+		t.Errorf("Array.MapSeq() = %q", got)
+	}
+	if got := MapTo(a, Str.Len); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("MapTo() = %v", got)
+	}
+	if got := a.Reduce("", func(acc, v Str) Str { return acc + v }); got != "abbccc" {
+		t.Errorf("Array.Reduce() = %q", got)
+	}
+	if got := ReduceTo(a, 0, func(n int, v Str) int { return n + v.Len() }); got != 6 {
+		t.Errorf("ReduceTo() = %d", got)
+	}
+
+	var seen []int
+	a.Each(func(i int, _ Str) { seen = append(seen, i) })
+	if !slices.Equal(seen, []int{0, 1, 2}) {
+		t.Errorf("Array.Each() visited %v", seen)
+	}
+
+	for v := range a.FilterSeq(func(s Str) bool { return s.Len() > 1 }) {
+		if v != "bb" {
+			t.Errorf("Array.FilterSeq() yielded %q after break", v)
+		}
+		break
+	}
+}
+
+func TestArrayChunkWindow(t *testing.T) {
+	a := Array{"1", "2", "3", "4", "5"}
+
+	chunks := a.Chunk(2)
+	if len(chunks) != 3 || !slices.Equal(chunks[0], Array{"1", "2"}) || !slices.Equal(chunks[2], Array{"5"}) {
+		t.Errorf("Array.Chunk() = %q", chunks)
+	}
+	// Appending to a chunk must not overwrite the next one.
+	_ = append(chunks[0], "x")
+	if a[2] != "3" {
+		t.Errorf("appending to a chunk modified the array: %q", a)
+	}
+	if got := a.Chunk(0); got != nil {
+		t.Errorf("Array.Chunk(0) = %q, want nil", got)
+	}
+
+	windows := a.Window(3)
+	if len(windows) != 3 || !slices.Equal(windows[1], Array{"2", "3", "4"}) {
+		t.Errorf("Array.Window() = %q", windows)
+	}
+	if got := a.Window(6); got != nil {
+		t.Errorf("Array.Window(6) = %q, want nil", got)
+	}
+}
+
+func TestFlattenZip(t *testing.T) {
+	if got := Flatten([]Array{{"a"}, nil, {"b", "c"}}); !slices.Equal(got, Array{"a", "b", "c"}) {
+		t.Errorf("Flatten() = %q", got)
+	}
+
+	got := Array{"a", "b", "c"}.Zip(Array{"1", "2"})
+	if !slices.Equal(got, [][2]Str{{"a", "1"}, {"b", "2"}}) {
+		t.Errorf("Array.Zip() = %q", got)
+	}
+}
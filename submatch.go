@@ -0,0 +1,173 @@
+package str
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ErrNoMatch = errors.New("str: no match")
+
+func (s Str) FindSubmatch(pattern string) Array {
+	if re := compilePattern(pattern); re != nil {
+		return s.FindSubmatchRegex(re)
+	}
+	return nil
+}
+
+func (s Str) FindAllSubmatch(pattern string) []Array {
+	if re := compilePattern(pattern); re != nil {
+		return s.FindAllSubmatchRegex(re)
+	}
+	return nil
+}
+
+func (s Str) FindNamed(pattern string) map[string]Str {
+	if re := compilePattern(pattern); re != nil {
+		return s.FindNamedRegex(re)
+	}
+	return nil
+}
+
+func (s Str) FindSubmatchRegex(re *regexp.Regexp) Array {
+	return NewArray(re.FindStringSubmatch(string(s)))
+}
+
+func (s Str) FindAllSubmatchRegex(re *regexp.Regexp) []Array {
+	matches := re.FindAllStringSubmatch(string(s), -1)
+	if matches == nil {
+		return nil
+	}
+
+	out := make([]Array, len(matches))
+	for i := range matches {
+		out[i] = NewArray(matches[i])
+	}
+	return out
+}
+
+// FindNamedRegex returns the named groups of the leftmost match of re. Groups
+// that did not participate in the match are omitted.
+func (s Str) FindNamedRegex(re *regexp.Regexp) map[string]Str {
+	loc := re.FindStringSubmatchIndex(string(s))
+	if loc == nil {
+		return nil
+	}
+	return namedGroups(re, s, loc)
+}
+
+// ScanInto matches pattern against s and stores its named groups in the
+// fields of the struct dst points to. See ScanIntoRegex.
+func (s Str) ScanInto(pattern string, dst any) error {
+	re, err := regexpCache.get(pattern)
+	if err != nil {
+		return err
+	}
+	return s.ScanIntoRegex(re, dst)
+}
+
+// ScanIntoRegex matches re against s and stores its named groups in the
+// fields of the struct dst points to. A field receives the group named by its
+// `str:"group"` tag, or else the group matching the field's name without
+// regard to case; a tag of "-" skips the field. Fields may be strings,
+// numbers, bools or implement encoding.TextUnmarshaler. It returns ErrNoMatch
+// if re does not match.
+func (s Str) ScanIntoRegex(re *regexp.Regexp, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("str: ScanInto requires a non-nil struct pointer, got %T", dst)
+	}
+
+	groups := s.FindNamedRegex(re)
+	if groups == nil {
+		return ErrNoMatch
+	}
+
+	rv = rv.Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, tagged := field.Tag.Lookup("str")
+		switch {
+		case name == "-":
+			continue
+		case !tagged:
+			name = groupName(re, field.Name)
+		case re.SubexpIndex(name) < 0:
+			return fmt.Errorf("str: field %s: pattern has no group %q", field.Name, name)
+		}
+
+		value, ok := groups[name]
+		if !ok {
+			continue
+		}
+		if err := setField(rv.Field(i), value); err != nil {
+			return fmt.Errorf("str: field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+func groupName(re *regexp.Regexp, field string) string {
+	for _, name := range re.SubexpNames() {
+		if strings.EqualFold(name, field) {
+			return name
+		}
+	}
+	return field
+}
+
+func setField(v reflect.Value, value Str) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(string(value))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := value.ParseInt()
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(n) {
+			return fmt.Errorf("value %s overflows %s", value, v.Type())
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := value.ParseUint()
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(n) {
+			return fmt.Errorf("value %s overflows %s", value, v.Type())
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := value.ParseFloat()
+		if err != nil {
+			return err
+		}
+		if v.OverflowFloat(n) {
+			return fmt.Errorf("value %s overflows %s", value, v.Type())
+		}
+		v.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(string(value))
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
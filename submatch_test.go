@@ -0,0 +1,162 @@
+package str
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFindSubmatch(t *testing.T) {
+	s := Str("key=value other=thing")
+	if got := s.FindSubmatch(`(\w+)=(\w+)`).Strings(); !slices.Equal(got, []string{"key=value", "key", "value"}) {
+		t.Errorf("Str.FindSubmatch() = %q", got)
+	}
+	if got := s.FindSubmatch(`(\d+)`); got != nil {
+		t.Errorf("Str.FindSubmatch() = %q, want nil", got)
+	}
+	if got := s.FindSubmatch(`(`); got != nil {
+		t.Errorf("Str.FindSubmatch() = %q, want nil for invalid pattern", got)
+	}
+
+	all := s.FindAllSubmatch(`(\w+)=(\w+)`)
+	if len(all) != 2 || !slices.Equal(all[1].Strings(), []string{"other=thing", "other", "thing"}) {
+		t.Errorf("Str.FindAllSubmatch() = %q", all)
+	}
+	if got := s.FindAllSubmatch(`\d`); got != nil {
+		t.Errorf("Str.FindAllSubmatch() = %q, want nil", got)
+	}
+}
+
+func TestFindNamed(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		pattern  string
+		expected map[string]Str
+	}{
+		{
+			name:     "named groups",
+			input:    "2025-03-14",
+			pattern:  `(?P<year>\d{4})-(?P<month>\d{2})-(?P<day>\d{2})`,
+			expected: map[string]Str{"year": "2025", "month": "03", "day": "14"},
+		},
+		{
+			name:     "unmatched optional group omitted",
+			input:    "v1",
+			pattern:  `v(?P<major>\d+)(?:\.(?P<minor>\d+))?`,
+			expected: map[string]Str{"major": "1"},
+		},
+		{
+			name:     "no match",
+			input:    "abc",
+			pattern:  `(?P<n>\d+)`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.FindNamed(tt.pattern)
+			if (got == nil) != (tt.expected == nil) || len(got) != len(tt.expected) {
+				t.Fatalf("Str.FindNamed() = %v, want %v", got, tt.expected)
+			}
+			for k, v := range tt.expected {
+				if got[k] != v {
+					t.Errorf("Str.FindNamed()[%q] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestScanInto(t *testing.T) {
+	type logLine struct {
+		Time    time.Time `str:"ts"`
+		Level   Str       `str:"level"`
+		Status  int       `str:"status"`
+		Bytes   uint32
+		Latency float64 `str:"latency"`
+		Cached  bool    `str:"cached"`
+		Path    string  `str:"path"`
+		Ignored string  `str:"-"`
+		note    string
+	}
+
+	const pattern = `^(?P<ts>\S+) (?P<level>\w+) (?P<path>\S+) (?P<status>\d+) (?P<Bytes>\d+) (?P<latency>[\d.]+)ms(?: cached=(?P<cached>\w+))?`
+	var got logLine
+	got.Ignored = "keep"
+	err := Str("2025-01-02T03:04:05Z INFO /api/users 200 5120 12.5ms cached=true").ScanInto(pattern, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := logLine{
+		Time:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Level:   "INFO",
+		Status:  200,
+		Bytes:   5120,
+		Latency: 12.5,
+		Cached:  true,
+		Path:    "/api/users",
+		Ignored: "keep",
+	}
+	if got != want {
+		t.Errorf("Str.ScanInto() = %+v, want %+v", got, want)
+	}
+
+	tests := []struct {
+		name    string
+		input   Str
+		pattern string
+		dst     any
+		wantErr error
+	}{
+		{
+			name:    "no match",
+			input:   "nothing here",
+			pattern: pattern,
+			dst:     &logLine{},
+			wantErr: ErrNoMatch,
+		},
+		{
+			name:    "conversion error",
+			input:   "x",
+			pattern: `(?P<status>\w)`,
+			dst:     &struct{ Status int8 }{},
+		},
+		{
+			name:    "overflow",
+			input:   "300",
+			pattern: `(?P<Status>\d+)`,
+			dst:     &struct{ Status int8 }{},
+		},
+		{
+			name:    "missing tagged group",
+			input:   "1",
+			pattern: `(?P<a>\d)`,
+			dst: &struct {
+				B int `str:"b"`
+			}{},
+		},
+		{
+			name:    "not a struct pointer",
+			input:   "1",
+			pattern: `(?P<a>\d)`,
+			dst:     logLine{},
+		},
+		{
+			name:    "invalid pattern",
+			input:   "1",
+			pattern: `(?P<a>`,
+			dst:     &logLine{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.ScanInto(tt.pattern, tt.dst)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("Str.ScanInto() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Measure is the unit in which the truncation methods count length.
+// Whatever the unit, text is only ever cut between grapheme clusters.
+type Measure uint8
+
+const (
+	MeasureGraphemes Measure = iota
+	MeasureRunes
+	MeasureBytes
+	MeasureWidth // terminal display columns, see DisplayWidth
+)
+
+type truncateOptions struct {
+	measure Measure
+}
+
+type TruncateOption func(*truncateOptions)
+
+// WithTruncateMeasure sets the unit of the length limit, MeasureGraphemes by
+// default.
+func WithTruncateMeasure(m Measure) TruncateOption {
+	return func(o *truncateOptions) {
+		o.measure = m
+	}
+}
+
+func truncateMeasure(opts []TruncateOption) Measure {
+	var o truncateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o.measure
+}
+
+// size returns the length of s in unit m.
+func (m Measure) size(s string) int {
+	switch m {
+	case MeasureRunes:
+		return utf8.RuneCountInString(s)
+	case MeasureBytes:
+		return len(s)
+	case MeasureWidth:
+		return Str(s).DisplayWidth()
+	}
+	return Str(s).GraphemeCount()
+}
+
+// prefix returns the byte length of the longest prefix of s made of whole
+// grapheme clusters that fits in n units.
+func (m Measure) prefix(s string, n int) int {
+	end := 0
+	for end < len(s) {
+		g := graphemeLen(s[end:])
+		if n -= m.size(s[end : end+g]); n < 0 {
+			break
+		}
+		end += g
+	}
+	return end
+}
+
+// suffix is like prefix for the end of s and returns the start offset.
+func (m Measure) suffix(s string, n int) int {
+	offsets := graphemeOffsets(s)
+	i := len(offsets) - 1
+	for i > 0 {
+		if n -= m.size(s[offsets[i-1]:offsets[i]]); n < 0 {
+			break
+		}
+		i--
+	}
+	return offsets[i]
+}
+
+// Truncate shortens s to at most n units, ellipsis included, by cutting its
+// end and appending ellipsis. s is returned unchanged if it fits.
+func (s Str) Truncate(n int, ellipsis string, opts ...TruncateOption) Str {
+	m := truncateMeasure(opts)
+	if m.size(string(s)) <= n {
+		return s
+	}
+	budget := n - m.size(ellipsis)
+	if budget < 0 {
+		return Str(ellipsis[:m.prefix(ellipsis, n)])
+	}
+	return s[:m.prefix(string(s), budget)] + Str(ellipsis)
+}
+
+// TruncateBytes returns the longest prefix of s of at most n bytes that does
+// not split a grapheme cluster, and hence not a UTF-8 sequence either.
+func (s Str) TruncateBytes(n int) Str {
+	return s[:MeasureBytes.prefix(string(s), max(n, 0))]
+}
+
+// TruncateMiddle is like Truncate but keeps both ends of s and puts ellipsis
+// in the middle, which suits paths and identifiers.
+func (s Str) TruncateMiddle(n int, ellipsis string, opts ...TruncateOption) Str {
+	m := truncateMeasure(opts)
+	if m.size(string(s)) <= n {
+		return s
+	}
+	budget := n - m.size(ellipsis)
+	if budget < 0 {
+		return Str(ellipsis[:m.prefix(ellipsis, n)])
+	}
+
+	head := m.prefix(string(s), (budget+1)/2)
+	tail := m.suffix(string(s), budget-m.size(string(s[:head])))
+	return s[:head] + Str(ellipsis) + s[tail:]
+}
+
+// TruncateWords is like Truncate but cuts s at the end of a word. It falls
+// back to cutting within the first word when even that does not fit.
+func (s Str) TruncateWords(n int, ellipsis string, opts ...TruncateOption) Str {
+	m := truncateMeasure(opts)
+	if m.size(string(s)) <= n {
+		return s
+	}
+	budget := n - m.size(ellipsis)
+	if budget < 0 {
+		return Str(ellipsis[:m.prefix(ellipsis, n)])
+	}
+
+	end := m.prefix(string(s), budget)
+	if r, _ := utf8.DecodeRuneInString(string(s[end:])); !unicode.IsSpace(r) {
+		if i := strings.LastIndexFunc(string(s[:end]), unicode.IsSpace); i >= 0 {
+			end = i
+		}
+	}
+	return Str(strings.TrimRightFunc(string(s[:end]), unicode.IsSpace)) + Str(ellipsis)
+}
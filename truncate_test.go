@@ -0,0 +1,95 @@
+package str
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		n        int
+		ellipsis string
+		measure  Measure
+		expected Str
+	}{
+		{"fits", "hello", 5, "…", MeasureGraphemes, "hello"},
+		{"graphemes", "hello world", 8, "…", MeasureGraphemes, "hello w…"},
+		{"combining mark kept", "cafe\u0301st", 5, "…", MeasureGraphemes, "cafe\u0301…"},
+		{"runes do not split clusters", "cafe\u0301s", 5, "…", MeasureRunes, "caf…"},
+		{"bytes", "héllo", 5, "...", MeasureBytes, "h..."},
+		{"width", "日本語テキスト", 7, "…", MeasureWidth, "日本語…"},
+		{"ellipsis too long", "hello", 2, "...", MeasureGraphemes, ".."},
+		{"empty ellipsis", "hello", 3, "", MeasureGraphemes, "hel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Truncate(tt.n, tt.ellipsis, WithTruncateMeasure(tt.measure)); got != tt.expected {
+				t.Errorf("Str.Truncate() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTruncateMiddle(t *testing.T) {
+	tests := []struct {
+		input    Str
+		n        int
+		expected Str
+	}{
+		{"abcdefghijklmnop", 7, "abc…nop"},
+		{"abcdefghijklmnop", 6, "abc…op"},
+		{"/usr/local/share/doc", 12, "/usr/l…e/doc"},
+		{"short", 10, "short"},
+		{"🇩🇪🇫🇷🇮🇹🇪🇸", 3, "🇩🇪…🇪🇸"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.TruncateMiddle(tt.n, "…"); got != tt.expected {
+			t.Errorf("Str(%q).TruncateMiddle(%d) = %q, want %q", tt.input, tt.n, got, tt.expected)
+		}
+	}
+}
+
+func TestTruncateWords(t *testing.T) {
+	tests := []struct {
+		input    Str
+		n        int
+		expected Str
+	}{
+		{"The quick brown fox", 14, "The quick…"},
+		{"The quick brown fox", 16, "The quick brown…"},
+		{"The quick brown fox", 19, "The quick brown fox"},
+		{"Supercalifragilistic word", 6, "Super…"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.TruncateWords(tt.n, "…"); got != tt.expected {
+			t.Errorf("Str(%q).TruncateWords(%d) = %q, want %q", tt.input, tt.n, got, tt.expected)
+		}
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	tests := []struct {
+		input    Str
+		n        int
+		expected Str
+	}{
+		{"hello", 10, "hello"},
+		{"héllo", 2, "h"},
+		{"héllo", 3, "hé"},
+		{"日本語", 8, "日本"},
+		{"👍🏽ok", 7, ""},
+		{"abc", -1, ""},
+	}
+
+	for _, tt := range tests {
+		got := tt.input.TruncateBytes(tt.n)
+		if got != tt.expected || !utf8.ValidString(string(got)) {
+			t.Errorf("Str(%q).TruncateBytes(%d) = %q, want %q", tt.input, tt.n, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// runeWidth returns the number of terminal columns r occupies on its own.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7F && r < 0xA0):
+		return 0
+	case r >= 0x1160 && r <= 0x11FF:
+		// Hangul medial vowels and final consonants join the preceding jamo.
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case isEmojiPresentation(r):
+		return 2
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+	return 1
+}
+
+// graphemeWidth returns the number of terminal columns a single grapheme
+// cluster occupies.
+func graphemeWidth(cluster string) int {
+	first, size := utf8.DecodeRuneInString(cluster)
+	w := runeWidth(first)
+	if size == len(cluster) {
+		return w
+	}
+	if lookupProperty(graphemeBreakRanges, first) == gbRegionalIndicator {
+		return 2
+	}
+
+	for _, r := range cluster[size:] {
+		switch {
+		case r == '\uFE0F' && isExtendedPictographic(first):
+			return 2
+		case r == '\uFE0E':
+			return 1
+		case w == 0:
+			w = runeWidth(r)
+		}
+	}
+	return w
+}
+
+func (s Str) DisplayWidth() int {
+	w := 0
+	for rest := string(s); rest != ""; {
+		n := graphemeLen(rest)
+		w += graphemeWidth(rest[:n])
+		rest = rest[n:]
+	}
+	return w
+}
+
+func (s Str) Center(width int, pad string) Str {
+	fill := width - s.DisplayWidth()
+	if fill <= 0 {
+		return s
+	}
+	left := fill / 2
+	return widthPadding(pad, left) + s + widthPadding(pad, fill-left)
+}
+
+func (s Str) PadEndWidth(width int, pad string) Str {
+	if fill := width - s.DisplayWidth(); fill > 0 {
+		return s + widthPadding(pad, fill)
+	}
+	return s
+}
+
+func (s Str) PadStartWidth(width int, pad string) Str {
+	if fill := width - s.DisplayWidth(); fill > 0 {
+		return widthPadding(pad, fill) + s
+	}
+	return s
+}
+
+// TruncateWidth is Truncate with the limit in display columns.
+func (s Str) TruncateWidth(width int, ellipsis string) Str {
+	return s.Truncate(width, ellipsis, WithTruncateMeasure(MeasureWidth))
+}
+
+// prefixWidth returns the longest prefix of s made of whole grapheme clusters
+// that fits in width columns.
+func prefixWidth(s string, width int) Str {
+	end := 0
+	for end < len(s) {
+		n := graphemeLen(s[end:])
+		if width -= graphemeWidth(s[end : end+n]); width < 0 {
+			break
+		}
+		end += n
+	}
+	return Str(s[:end])
+}
+
+// widthPadding repeats the clusters of pad to fill exactly width columns,
+// completing with spaces when a wide cluster would overflow.
+func widthPadding(pad string, width int) Str {
+	var clusters []string
+	for _, c := range graphemes(pad) {
+		if graphemeWidth(c) > 0 {
+			clusters = append(clusters, c)
+		}
+	}
+
+	var b strings.Builder
+	for i := 0; width > 0 && len(clusters) > 0; i = (i + 1) % len(clusters) {
+		w := graphemeWidth(clusters[i])
+		if w > width {
+			break
+		}
+		b.WriteString(clusters[i])
+		width -= w
+	}
+	b.WriteString(strings.Repeat(" ", width))
+	return Str(b.String())
+}
@@ -0,0 +1,158 @@
+package str
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		expected int
+	}{
+		{name: "ascii", input: "hello", expected: 5},
+		{name: "cjk", input: "你好", expected: 4},
+		{name: "fullwidth", input: "ＡＢ", expected: 4},
+		{name: "halfwidth katakana", input: "ｶﾀｶﾅ", expected: 4},
+		{name: "combining accent", input: "é", expected: 1},
+		{name: "emoji", input: "🚀", expected: 2},
+		{name: "zwj sequence", input: "👨‍👩‍👧", expected: 2},
+		{name: "skin tone", input: "👍🏽", expected: 2},
+		{name: "flag", input: "🇯🇵", expected: 2},
+		{name: "text presentation selector", input: "\u263a\ufe0e", expected: 1},
+		{name: "emoji presentation selector", input: "\u263a\ufe0f", expected: 2},
+		{name: "hangul jamo", input: "각", expected: 2},
+		{name: "zero width space", input: "a\u200bb", expected: 2},
+		{name: "control characters", input: "a\tb\x00", expected: 2},
+		{name: "mixed", input: "ID: 山田 🎉", expected: 11},
+		{name: "empty", input: "", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.DisplayWidth(); got != tt.expected {
+				t.Errorf("Str.DisplayWidth() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPadWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		pad      func(Str, int, string) Str
+		input    Str
+		width    int
+		padding  string
+		expected string
+	}{
+		{
+			name:     "end pads cjk by columns",
+			pad:      Str.PadEndWidth,
+			input:    "東京",
+			width:    6,
+			padding:  " ",
+			expected: "東京  ",
+		},
+		{
+			name:     "start pads emoji by columns",
+			pad:      Str.PadStartWidth,
+			input:    "🍣",
+			width:    5,
+			padding:  ".",
+			expected: "...🍣",
+		},
+		{
+			name:     "wide pad falls back to spaces",
+			pad:      Str.PadEndWidth,
+			input:    "a",
+			width:    4,
+			padding:  "＊",
+			expected: "a＊ ",
+		},
+		{
+			name:     "center",
+			pad:      Str.Center,
+			input:    "漢字",
+			width:    9,
+			padding:  "-",
+			expected: "--漢字---",
+		},
+		{
+			name:     "already wide enough",
+			pad:      Str.Center,
+			input:    "你好世界",
+			width:    6,
+			padding:  "-",
+			expected: "你好世界",
+		},
+		{
+			name:     "empty pad uses spaces",
+			pad:      Str.PadEndWidth,
+			input:    "x",
+			width:    3,
+			padding:  "",
+			expected: "x  ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pad(tt.input, tt.width, tt.padding); got != Str(tt.expected) {
+				t.Errorf("Str pad = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		width    int
+		ellipsis string
+		expected string
+	}{
+		{
+			name:     "fits",
+			input:    "hello",
+			width:    5,
+			ellipsis: "…",
+			expected: "hello",
+		},
+		{
+			name:     "ascii",
+			input:    "hello world",
+			width:    8,
+			ellipsis: "...",
+			expected: "hello...",
+		},
+		{
+			name:     "wide character does not overflow",
+			input:    "日本語テキスト",
+			width:    7,
+			ellipsis: "…",
+			expected: "日本語…",
+		},
+		{
+			name:     "emoji sequence is not split",
+			input:    "hi👨‍👩‍👧there",
+			width:    4,
+			ellipsis: "…",
+			expected: "hi…",
+		},
+		{
+			name:     "ellipsis wider than width",
+			input:    "hello",
+			width:    2,
+			ellipsis: "...",
+			expected: "..",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.TruncateWidth(tt.width, tt.ellipsis); got != Str(tt.expected) {
+				t.Errorf("Str.TruncateWidth() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
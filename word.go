@@ -0,0 +1,252 @@
+package str
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// wordSegments splits s at the word boundaries defined by UAX #29. Every
+// byte of s belongs to exactly one segment, including spaces and punctuation.
+func wordSegments(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	runes := make([]rune, 0, len(s))
+	props := make([]uint8, 0, len(s))
+	offsets := make([]int, 0, len(s)+1)
+	for i, r := range s {
+		runes = append(runes, r)
+		props = append(props, lookupProperty(wordBreakRanges, r))
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(s))
+
+	var segments []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if isWordBoundary(runes, props, i) {
+			segments = append(segments, s[offsets[start]:offsets[i]])
+			start = i
+		}
+	}
+	return append(segments, s[offsets[start]:])
+}
+
+// isWordBoundary reports whether there is a word boundary between the runes
+// at i-1 and i.
+func isWordBoundary(runes []rune, props []uint8, i int) bool {
+	prev, cur := props[i-1], props[i]
+	switch {
+	case prev == wbCR && cur == wbLF:
+		return false
+	case isWordNewline(prev) || isWordNewline(cur):
+		return true
+	case prev == wbZWJ && isExtendedPictographic(runes[i]):
+		return false
+	case prev == wbWSegSpace && cur == wbWSegSpace:
+		return false
+	case isWordIgnorable(cur):
+		return false
+	}
+
+	l := skipWordIgnorables(props, i-1)
+	left, leftLeft, rightRight := props[l], wbOther, wbOther
+	if l > 0 {
+		leftLeft = props[skipWordIgnorables(props, l-1)]
+	}
+	for j := i + 1; j < len(props); j++ {
+		if !isWordIgnorable(props[j]) {
+			rightRight = props[j]
+			break
+		}
+	}
+
+	switch {
+	case isAHLetter(left) && isAHLetter(cur):
+		return false
+	case isAHLetter(left) && isMidLetterQ(cur) && isAHLetter(rightRight):
+		return false
+	case isAHLetter(leftLeft) && isMidLetterQ(left) && isAHLetter(cur):
+		return false
+	case left == wbHebrewLetter && cur == wbSingleQuote:
+		return false
+	case left == wbHebrewLetter && cur == wbDoubleQuote && rightRight == wbHebrewLetter:
+		return false
+	case leftLeft == wbHebrewLetter && left == wbDoubleQuote && cur == wbHebrewLetter:
+		return false
+	case left == wbNumeric && cur == wbNumeric:
+		return false
+	case isAHLetter(left) && cur == wbNumeric:
+		return false
+	case left == wbNumeric && isAHLetter(cur):
+		return false
+	case leftLeft == wbNumeric && isMidNumQ(left) && cur == wbNumeric:
+		return false
+	case left == wbNumeric && isMidNumQ(cur) && rightRight == wbNumeric:
+		return false
+	case left == wbKatakana && cur == wbKatakana:
+		return false
+	case (isAHLetter(left) || left == wbNumeric || left == wbKatakana || left == wbExtendNumLet) && cur == wbExtendNumLet:
+		return false
+	case left == wbExtendNumLet && (isAHLetter(cur) || cur == wbNumeric || cur == wbKatakana):
+		return false
+	case left == wbRegionalIndicator && cur == wbRegionalIndicator:
+		count := 0
+		for j := l; props[j] == wbRegionalIndicator; j = skipWordIgnorables(props, j-1) {
+			count++
+			if j == 0 {
+				break
+			}
+		}
+		return count%2 == 0
+	}
+	return true
+}
+
+// skipWordIgnorables returns the index of the rune that the Extend, Format
+// and ZWJ runes ending at i attach to.
+func skipWordIgnorables(props []uint8, i int) int {
+	for i > 0 && isWordIgnorable(props[i]) && !isWordNewline(props[i-1]) {
+		i--
+	}
+	return i
+}
+
+func isWordIgnorable(p uint8) bool {
+	return p == wbExtend || p == wbFormat || p == wbZWJ
+}
+
+func isWordNewline(p uint8) bool {
+	return p == wbCR || p == wbLF || p == wbNewline
+}
+
+func isAHLetter(p uint8) bool {
+	return p == wbALetter || p == wbHebrewLetter
+}
+
+func isMidLetterQ(p uint8) bool {
+	return p == wbMidLetter || p == wbMidNumLet || p == wbSingleQuote
+}
+
+func isMidNumQ(p uint8) bool {
+	return p == wbMidNum || p == wbMidNumLet || p == wbSingleQuote
+}
+
+// identifierWords returns the word-like UAX #29 segments of s, further split
+// on separator runes and at the case and digit transitions found in
+// programming identifiers.
+func identifierWords(s string, isSep func(rune) bool, letterDigit, digitLetter bool) []string {
+	var words []string
+	segments := wordSegments(s)
+	for i, offset := 0, 0; i < len(segments); i++ {
+		seg := segments[i]
+		start := offset
+		offset += len(seg)
+		if !isWordLike(seg) {
+			continue
+		}
+		if isComplexContext(seg) {
+			// Scripts such as Thai are not separated by spaces and UAX #29
+			// leaves them to dictionary-based segmenters; keep runs together.
+			for i+1 < len(segments) && isComplexContext(segments[i+1]) {
+				i++
+				offset += len(segments[i])
+			}
+			words = append(words, s[start:offset])
+			continue
+		}
+		// UAX #29 likewise puts every ideograph and kana in a segment of its
+		// own; rejoin them with the word-like segments they touch, so that
+		// only case transitions split such runs.
+		for i+1 < len(segments) && isWordLike(segments[i+1]) &&
+			(isIdeographic(segments[i]) || isIdeographic(segments[i+1])) {
+			i++
+			offset += len(segments[i])
+		}
+		words = splitIdentifier(words, s[start:offset], isSep, letterDigit, digitLetter)
+	}
+	return words
+}
+
+func splitIdentifier(words []string, s string, isSep func(rune) bool, letterDigit, digitLetter bool) []string {
+	start := -1
+	var prev rune
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case isSep(r):
+			if start >= 0 {
+				words = append(words, s[start:i])
+			}
+			start = -1
+		case start < 0:
+			start = i
+		default:
+			next, nextSize := utf8.DecodeRuneInString(s[i+size:])
+			after, _ := utf8.DecodeRuneInString(s[i+size+nextSize:])
+			if isIdentifierBoundary(prev, r, next, after, letterDigit, digitLetter) {
+				words = append(words, s[start:i])
+				start = i
+			}
+		}
+		prev = r
+		i += size
+	}
+	if start >= 0 {
+		words = append(words, s[start:])
+	}
+	return words
+}
+
+func isIdentifierBoundary(prev, cur, next, after rune, letterDigit, digitLetter bool) bool {
+	switch {
+	case isUpperOrTitle(cur) && !isUpperOrTitle(prev):
+		return true
+	case isUpperOrTitle(cur) && isUpperOrTitle(prev) && unicode.IsLower(next):
+		// "HTTPServer" splits before the last capital, but a trailing
+		// lower-case s pluralizes the acronym as in "userIDs".
+		return next != 's' || unicode.IsLower(after)
+	case letterDigit && unicode.IsLetter(prev) && unicode.IsDigit(cur):
+		return true
+	case digitLetter && unicode.IsDigit(prev) && unicode.IsLetter(cur):
+		return true
+	}
+	return false
+}
+
+func isUpperOrTitle(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsTitle(r)
+}
+
+func isWordLike(seg string) bool {
+	for _, r := range seg {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func isComplexContext(seg string) bool {
+	letter := false
+	for _, r := range seg {
+		switch {
+		case unicode.In(r, unicode.Thai, unicode.Lao, unicode.Khmer, unicode.Myanmar):
+			letter = letter || unicode.IsLetter(r)
+		case unicode.Is(unicode.Mn, r):
+		default:
+			return false
+		}
+	}
+	return letter
+}
+
+func isIdeographic(seg string) bool {
+	for _, r := range seg {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package str
+
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// readBreakTest parses a Unicode break test file such as WordBreakTest.txt
+// into the input strings and their expected segments.
+func readBreakTest(t *testing.T, name string) (inputs []string, expected [][]string) {
+	t.Helper()
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+
+		var input strings.Builder
+		var segments []string
+		for _, seg := range strings.Split(strings.Trim(line, "÷ "), "÷") {
+			var b strings.Builder
+			for _, code := range strings.Split(seg, "×") {
+				v, err := strconv.ParseUint(strings.TrimSpace(code), 16, 32)
+				if err != nil {
+					t.Fatalf("%s: %v", line, err)
+				}
+				b.WriteRune(rune(v))
+			}
+			input.WriteString(b.String())
+			segments = append(segments, b.String())
+		}
+		inputs = append(inputs, input.String())
+		expected = append(expected, segments)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return inputs, expected
+}
+
+func TestWordSegments(t *testing.T) {
+	inputs, expected := readBreakTest(t, "testdata/WordBreakTest.txt")
+	for i := range inputs {
+		if got := wordSegments(inputs[i]); !slices.Equal(got, expected[i]) {
+			t.Errorf("wordSegments(%+q) = %+q, want %+q", inputs[i], got, expected[i])
+		}
+	}
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		expected []string
+	}{
+		{
+			name:     "acronym then word",
+			input:    "HTTPServer",
+			expected: []string{"HTTP", "Server"},
+		},
+		{
+			name:     "trailing acronym",
+			input:    "parseHTTP",
+			expected: []string{"parse", "HTTP"},
+		},
+		{
+			name:     "plural acronym",
+			input:    "userIDs",
+			expected: []string{"user", "IDs"},
+		},
+		{
+			name:     "plural acronym then word",
+			input:    "userIDsList",
+			expected: []string{"user", "IDs", "List"},
+		},
+		{
+			name:     "leading plural acronym",
+			input:    "PDFsAndDocs",
+			expected: []string{"PDFs", "And", "Docs"},
+		},
+		{
+			name:     "plural acronym then acronym",
+			input:    "parseURLsFromHTML",
+			expected: []string{"parse", "URLs", "From", "HTML"},
+		},
+		{
+			name:     "digit inside acronym",
+			input:    "utf8Decoder",
+			expected: []string{"utf8", "Decoder"},
+		},
+		{
+			name:     "version prefix",
+			input:    "v2Api",
+			expected: []string{"v2", "Api"},
+		},
+		{
+			name:     "sentence with punctuation",
+			input:    "Hello, world! It's 3.14",
+			expected: []string{"Hello", "world", "It", "s", "3", "14"},
+		},
+		{
+			name:     "ideographs",
+			input:    "你好world",
+			expected: []string{"你好world"},
+		},
+		{
+			name:     "hiragana and kanji run",
+			input:    "日本語のテキスト ひらがな",
+			expected: []string{"日本語のテキスト", "ひらがな"},
+		},
+		{
+			name:     "ideographs after camel case",
+			input:    "userNameの名前",
+			expected: []string{"user", "Nameの名前"},
+		},
+		{
+			name:     "katakana run",
+			input:    "カタカナ_test",
+			expected: []string{"カタカナ", "test"},
+		},
+		{
+			name:     "thai run",
+			input:    "สวัสดี ครับ",
+			expected: []string{"สวัสดี", "ครับ"},
+		},
+		{
+			name:     "emoji dropped",
+			input:    "launch🚀now",
+			expected: []string{"launch", "now"},
+		},
+		{
+			name:     "empty",
+			input:    "",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Words().Strings(); !slices.Equal(got, tt.expected) {
+				t.Errorf("Str.Words() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWordsDigitSplit(t *testing.T) {
+	c := NewCaser(WithLetterDigitSplit(true), WithDigitLetterSplit(true))
+	if got := c.Words("utf8Decoder2fa").Strings(); !slices.Equal(got, []string{"utf", "8", "Decoder", "2", "fa"}) {
+		t.Errorf("Caser.Words() = %q", got)
+	}
+	if got := c.Snake("hello123world"); got != "hello_123_world" {
+		t.Errorf("Caser.Snake() = %q", got)
+	}
+}
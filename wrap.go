@@ -0,0 +1,258 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+type wrapOptions struct {
+	breakLongWords bool
+	hyphenate      bool
+	keepNewlines   bool
+	indent         string
+	hanging        string
+	hasHanging     bool
+}
+
+type WrapOption func(*wrapOptions)
+
+// WithWrapBreakLongWords cuts words wider than the line at grapheme cluster
+// boundaries. By default they are left whole and overflow the line.
+func WithWrapBreakLongWords() WrapOption {
+	return func(o *wrapOptions) {
+		o.breakLongWords = true
+	}
+}
+
+// WithWrapHyphenation allows lines to break after hyphens and at soft hyphens
+// (U+00AD), which are shown as "-" when a line breaks there and dropped
+// otherwise.
+func WithWrapHyphenation() WrapOption {
+	return func(o *wrapOptions) {
+		o.hyphenate = true
+	}
+}
+
+// WithWrapKeepNewlines keeps the existing line breaks and only wraps the lines
+// that are too wide, instead of reflowing each paragraph.
+func WithWrapKeepNewlines() WrapOption {
+	return func(o *wrapOptions) {
+		o.keepNewlines = true
+	}
+}
+
+// WithWrapIndent prefixes every line with indent.
+func WithWrapIndent(indent string) WrapOption {
+	return func(o *wrapOptions) {
+		o.indent = indent
+	}
+}
+
+// WithWrapHangingIndent prefixes every line but the first of each paragraph
+// with indent, overriding WithWrapIndent for them.
+func WithWrapHangingIndent(indent string) WrapOption {
+	return func(o *wrapOptions) {
+		o.hanging, o.hasHanging = indent, true
+	}
+}
+
+// Wrap breaks s into lines of at most width display columns, including any
+// indent. Paragraphs are separated by blank lines, which are preserved; the
+// lines within a paragraph are reflowed. Wide characters such as CJK
+// ideographs may be broken between without a space.
+func (s Str) Wrap(width int, opts ...WrapOption) Str {
+	var o wrapOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !o.hasHanging {
+		o.hanging = o.indent
+	}
+
+	text := strings.ReplaceAll(string(s), "\r\n", "\n")
+	trailing := strings.HasSuffix(text, "\n")
+	text = strings.TrimSuffix(text, "\n")
+
+	var out []string
+	var paragraph []string
+	flush := func() {
+		if paragraph != nil {
+			out = append(out, wrapParagraph(strings.Join(paragraph, " "), width, &o)...)
+			paragraph = nil
+		}
+	}
+	for _, line := range strings.Split(text, "\n") {
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+			out = append(out, "")
+		case o.keepNewlines:
+			paragraph = []string{line}
+			flush()
+		default:
+			paragraph = append(paragraph, line)
+		}
+	}
+	flush()
+
+	result := strings.Join(out, "\n")
+	if trailing {
+		result += "\n"
+	}
+	return Str(result)
+}
+
+// wrapPiece is an unbreakable part of a word.
+type wrapPiece struct {
+	text  string
+	width int
+	// space is set on the first piece of a word, soft on pieces ending at a
+	// soft hyphen.
+	space, soft bool
+}
+
+func wrapParagraph(text string, width int, o *wrapOptions) []string {
+	var lines []string
+	var line strings.Builder
+	lineWidth, first := 0, true
+	prefix := func() string {
+		if first {
+			return o.indent
+		}
+		return o.hanging
+	}
+	limit := func() int {
+		return max(width-Str(prefix()).DisplayWidth(), 1)
+	}
+	emit := func() {
+		lines = append(lines, prefix()+strings.TrimRightFunc(line.String(), unicode.IsSpace))
+		line.Reset()
+		lineWidth, first = 0, false
+	}
+
+	var prev wrapPiece
+	for _, p := range wrapPieces(text, o.hyphenate) {
+		sep := 0
+		if p.space && lineWidth > 0 {
+			sep = 1
+		}
+		need := sep + p.width
+		if p.soft {
+			need++
+		}
+
+		if lineWidth > 0 && lineWidth+need > limit() {
+			if prev.soft && !p.space {
+				line.WriteByte('-')
+			}
+			emit()
+			sep = 0
+		}
+		if sep > 0 {
+			line.WriteByte(' ')
+			lineWidth++
+		}
+
+		rest := p.text
+		for o.breakLongWords && lineWidth+Str(rest).DisplayWidth() > limit() {
+			head := prefixWidth(rest, limit()-lineWidth)
+			if head == "" && lineWidth == 0 {
+				head = Str(rest[:graphemeLen(rest)])
+			}
+			line.WriteString(string(head))
+			emit()
+			rest = rest[len(head):]
+		}
+		line.WriteString(rest)
+		lineWidth += Str(rest).DisplayWidth()
+		prev = p
+	}
+	if lineWidth > 0 || len(lines) == 0 {
+		emit()
+	}
+	return lines
+}
+
+// wrapPieces splits text into words and words into the pieces a line may
+// break between: around wide graphemes and, with hyphenate, after hyphens and
+// at soft hyphens.
+func wrapPieces(text string, hyphenate bool) []wrapPiece {
+	var pieces []wrapPiece
+	for _, word := range strings.Fields(text) {
+		start := len(pieces)
+		var cur strings.Builder
+		curWidth := 0
+		cut := func(soft bool) {
+			if cur.Len() > 0 || soft {
+				pieces = append(pieces, wrapPiece{text: cur.String(), width: curWidth, soft: soft})
+				cur.Reset()
+				curWidth = 0
+			}
+		}
+
+		for _, g := range graphemes(word) {
+			w := graphemeWidth(g)
+			switch {
+			case hyphenate && g == "\u00AD":
+				cut(true)
+			case w == 2:
+				cut(false)
+				cur.WriteString(g)
+				curWidth = w
+				cut(false)
+			default:
+				cur.WriteString(g)
+				curWidth += w
+				if hyphenate && g == "-" {
+					cut(false)
+				}
+			}
+		}
+		cut(false)
+		if len(pieces) > start {
+			pieces[start].space = true
+		}
+	}
+	return pieces
+}
+
+// Unwrap joins the lines of each paragraph of s into a single line, undoing
+// Wrap. Paragraphs stay separated by a blank line. A line ending in a hyphen
+// is joined to the next without a space, as are lines meeting at a wide
+// character, since text such as Chinese or Japanese has no spaces to break at.
+func (s Str) Unwrap() Str {
+	text := strings.ReplaceAll(string(s), "\r\n", "\n")
+	trailing := strings.HasSuffix(text, "\n")
+
+	var paragraphs []string
+	var b strings.Builder
+	flush := func() {
+		if b.Len() > 0 {
+			paragraphs = append(paragraphs, b.String())
+			b.Reset()
+		}
+	}
+	var prev string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			flush()
+			continue
+		}
+		if b.Len() > 0 && !strings.HasSuffix(prev, "-") {
+			clusters := graphemes(prev)
+			if graphemeWidth(clusters[len(clusters)-1]) < 2 && graphemeWidth(line[:graphemeLen(line)]) < 2 {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(line)
+		prev = line
+	}
+	flush()
+
+	result := strings.Join(paragraphs, "\n\n")
+	if trailing && result != "" {
+		result += "\n"
+	}
+	return Str(result)
+}
@@ -0,0 +1,134 @@
+package str
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Str
+		width    int
+		opts     []WrapOption
+		expected Str
+	}{
+		{
+			name:     "greedy",
+			input:    "The quick brown fox jumps over the lazy dog",
+			width:    10,
+			expected: "The quick\nbrown fox\njumps over\nthe lazy\ndog",
+		},
+		{
+			name:     "reflow paragraphs",
+			input:    "one two\nthree\n\nfour  five six\n",
+			width:    9,
+			expected: "one two\nthree\n\nfour five\nsix\n",
+		},
+		{
+			name:     "keep newlines",
+			input:    "- one two three\n- four",
+			width:    9,
+			opts:     []WrapOption{WithWrapKeepNewlines()},
+			expected: "- one two\nthree\n- four",
+		},
+		{
+			name:     "long word overflows",
+			input:    "see https://example.com/a/long/path now",
+			width:    10,
+			expected: "see\nhttps://example.com/a/long/path\nnow",
+		},
+		{
+			name:     "long word broken",
+			input:    "abcdefghij klm",
+			width:    4,
+			opts:     []WrapOption{WithWrapBreakLongWords()},
+			expected: "abcd\nefgh\nij\nklm",
+		},
+		{
+			name:     "hyphenation",
+			input:    "a well-known extra\u00ADordinary case",
+			width:    10,
+			opts:     []WrapOption{WithWrapHyphenation()},
+			expected: "a well-\nknown\nextra-\nordinary\ncase",
+		},
+		{
+			name:     "soft hyphen dropped when unused",
+			input:    "extra\u00ADordinary",
+			width:    20,
+			opts:     []WrapOption{WithWrapHyphenation()},
+			expected: "extraordinary",
+		},
+		{
+			name:     "indent",
+			input:    "one two three four",
+			width:    10,
+			opts:     []WrapOption{WithWrapIndent("  ")},
+			expected: "  one two\n  three\n  four",
+		},
+		{
+			name:     "hanging indent",
+			input:    "-v, --verbose  print every file as it is processed",
+			width:    24,
+			opts:     []WrapOption{WithWrapHangingIndent("               ")},
+			expected: "-v, --verbose print\n               every\n               file as\n               it is\n               processed",
+		},
+		{
+			name:     "CJK",
+			input:    "日本語の文章を折り返す",
+			width:    8,
+			expected: "日本語の\n文章を折\nり返す",
+		},
+		{
+			name:     "mixed CJK and latin",
+			input:    "Go言語 is fun",
+			width:    6,
+			expected: "Go言語\nis fun",
+		},
+		{
+			name:     "CRLF",
+			input:    "a b\r\nc",
+			width:    80,
+			expected: "a b c",
+		},
+		{
+			name:     "empty",
+			input:    "",
+			width:    10,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Wrap(tt.width, tt.opts...); got != tt.expected {
+				t.Errorf("Str.Wrap() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	tests := []struct {
+		input    Str
+		expected Str
+	}{
+		{"The quick\nbrown fox\n\n\njumps  over\n  the lazy dog\n", "The quick brown fox\n\njumps  over the lazy dog\n"},
+		{"a well-\nknown fact", "a well-known fact"},
+		{"\n\n", ""},
+		{"one\r\ntwo", "one two"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.Unwrap(); got != tt.expected {
+			t.Errorf("Str(%q).Unwrap() = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+
+	text := Str("Lorem ipsum dolor sit amet, consectetur adipiscing elit.\n\nSed do eiusmod tempor.")
+	if got := text.Wrap(12).Unwrap(); got != text {
+		t.Errorf("Str.Wrap().Unwrap() = %q, want %q", got, text)
+	}
+
+	text = "日本語のテキストを折り返す"
+	if got := text.Wrap(6).Unwrap(); got != text {
+		t.Errorf("Str.Wrap().Unwrap() = %q, want %q", got, text)
+	}
+}